@@ -41,7 +41,12 @@ import (
 	intscheme "github.com/leki75/cert-manager/pkg/client/clientset/versioned/scheme"
 	informers "github.com/leki75/cert-manager/pkg/client/informers/externalversions"
 	"github.com/leki75/cert-manager/pkg/controller"
+	acmecertificaterequestcontroller "github.com/leki75/cert-manager/pkg/controller/certificaterequests/acme"
+	certificaterequestapprover "github.com/leki75/cert-manager/pkg/controller/certificaterequests/approver"
 	cacertificaterequestcontroller "github.com/leki75/cert-manager/pkg/controller/certificaterequests/ca"
+	selfsignedcertificaterequestcontroller "github.com/leki75/cert-manager/pkg/controller/certificaterequests/selfsigned"
+	vaultcertificaterequestcontroller "github.com/leki75/cert-manager/pkg/controller/certificaterequests/vault"
+	venaficertificaterequestcontroller "github.com/leki75/cert-manager/pkg/controller/certificaterequests/venafi"
 	"github.com/leki75/cert-manager/pkg/controller/clusterissuers"
 	"github.com/leki75/cert-manager/pkg/feature"
 	dnsutil "github.com/leki75/cert-manager/pkg/issuer/acme/dns/util"
@@ -76,7 +81,12 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) {
 
 	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRequestControllers) {
 		opts.EnabledControllers = append(opts.EnabledControllers, []string{
+			certificaterequestapprover.ControllerName,
+			acmecertificaterequestcontroller.CRControllerName,
 			cacertificaterequestcontroller.CRControllerName,
+			selfsignedcertificaterequestcontroller.CRControllerName,
+			vaultcertificaterequestcontroller.CRControllerName,
+			venaficertificaterequestcontroller.CRControllerName,
 		}...)
 	}
 
@@ -0,0 +1,103 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
+	"github.com/leki75/cert-manager/pkg/util"
+)
+
+// ClientOptions are the options used to build clients for the Kubernetes
+// cluster that the command operates on.
+type ClientOptions struct {
+	Kubeconfig string
+	Context    string
+	Namespace  string
+}
+
+func (o *ClientOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", "", ""+
+		"Path to a kubeconfig file. If not set, the default loading rules "+
+		"used by kubectl will be used.")
+	fs.StringVar(&o.Context, "context", "", ""+
+		"The name of the kubeconfig context to use.")
+	fs.StringVarP(&o.Namespace, "namespace", "n", "", ""+
+		"If present, the namespace scope for this request. If not set, the "+
+		"namespace of the current kubeconfig context will be used.")
+}
+
+// CMClient builds a cert-manager API client and returns it along with the
+// namespace that should be used for namespaced requests.
+func (o *ClientOptions) CMClient() (cmclient.Interface, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = o.Kubeconfig
+	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{
+		CurrentContext: o.Context,
+		Context: clientcmdapi.Context{
+			Namespace: o.Namespace,
+		},
+	})
+
+	namespace, _, err := config.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting namespace from kubeconfig: %v", err)
+	}
+
+	restConfig, err := config.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("error building client config: %v", err)
+	}
+
+	cl, err := cmclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("error building cert-manager client: %v", err)
+	}
+
+	return cl, namespace, nil
+}
+
+// NewCertManagerCtlCommand returns the root command of the cert-manager
+// command line tool. The binary can be installed as 'kubectl-cert_manager'
+// to be used as a kubectl plugin.
+func NewCertManagerCtlCommand(out, errOut io.Writer) *cobra.Command {
+	o := &ClientOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "kubectl cert-manager",
+		Short: fmt.Sprintf("Manage cert-manager resources in Kubernetes (%s) (%s)", util.AppVersion, util.AppGitCommit),
+		Long: `
+kubectl cert-manager is a CLI tool to manage and configure cert-manager
+resources for Kubernetes.`,
+		SilenceUsage: true,
+	}
+
+	o.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(NewRenewCommand(o, out, errOut))
+	cmd.AddCommand(NewRevokeCommand(o, out, errOut))
+
+	return cmd
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
+)
+
+const (
+	reasonManuallyTriggered  = "ManuallyTriggered"
+	messageManuallyTriggered = "Certificate renewal manually requested"
+)
+
+type RenewOptions struct {
+	*ClientOptions
+
+	All           bool
+	AllNamespaces bool
+
+	StdOut io.Writer
+	StdErr io.Writer
+}
+
+func (o *RenewOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.All, "all", false, ""+
+		"Renew all Certificates in the given namespace, or all namespaces "+
+		"if --all-namespaces is set.")
+	fs.BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, ""+
+		"Renew Certificates in all namespaces. Can only be used with --all.")
+}
+
+func (o *RenewOptions) Validate(args []string) error {
+	if len(args) == 0 && !o.All {
+		return fmt.Errorf("please supply one or more Certificate resource names or use the --all flag")
+	}
+	if len(args) > 0 && o.All {
+		return fmt.Errorf("cannot specify Certificate names in conjunction with the --all flag")
+	}
+	if o.AllNamespaces && !o.All {
+		return fmt.Errorf("the --all-namespaces flag can only be used with the --all flag")
+	}
+	return nil
+}
+
+// NewRenewCommand returns a command that marks Certificates for renewal.
+func NewRenewCommand(clientOpts *ClientOptions, out, errOut io.Writer) *cobra.Command {
+	o := &RenewOptions{
+		ClientOptions: clientOpts,
+		StdOut:        out,
+		StdErr:        errOut,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "renew [certificate-name...]",
+		Short: "Mark Certificates for manual renewal",
+		Long: `
+Mark one or more Certificates for renewal. The certificates controller will
+re-issue each Certificate using its issuer, and the existing Secret will be
+left in place until the new certificate has been issued.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			cl, namespace, err := o.CMClient()
+			if err != nil {
+				return err
+			}
+			return o.Run(cl, namespace, args)
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// Run marks the named Certificates, or all Certificates if the --all flag is
+// set, for renewal.
+func (o *RenewOptions) Run(cl cmclient.Interface, namespace string, args []string) error {
+	var crts []v1alpha1.Certificate
+	if o.All {
+		if o.AllNamespaces {
+			namespace = metav1.NamespaceAll
+		}
+		list, err := cl.CertmanagerV1alpha1().Certificates(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		crts = list.Items
+	} else {
+		for _, name := range args {
+			crt, err := cl.CertmanagerV1alpha1().Certificates(namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			crts = append(crts, *crt)
+		}
+	}
+
+	var errs []error
+	for i := range crts {
+		if err := o.renewCertificate(cl, &crts[i]); err != nil {
+			fmt.Fprintf(o.StdErr, "Failed to trigger renewal of Certificate %s/%s: %v\n", crts[i].Namespace, crts[i].Name, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (o *RenewOptions) renewCertificate(cl cmclient.Interface, crt *v1alpha1.Certificate) error {
+	crt = crt.DeepCopy()
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		apiutil.RequestCertificateRenewal(crt, reasonManuallyTriggered, messageManuallyTriggered)
+		_, err := cl.CertmanagerV1alpha1().Certificates(crt.Namespace).UpdateStatus(crt)
+		if !k8sErrors.IsConflict(err) {
+			return err
+		}
+		// the Certificate has been modified since it was read, so request
+		// the renewal again on the latest version of the resource
+		latest, getErr := cl.CertmanagerV1alpha1().Certificates(crt.Namespace).Get(crt.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		crt = latest
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.StdOut, "Manually triggered renewal of Certificate %s/%s\n", crt.Namespace, crt.Name)
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
+)
+
+const (
+	messageRevocationTriggered = "Certificate revocation manually requested"
+)
+
+var revocationReasons = []v1alpha1.RevocationReason{
+	v1alpha1.RevocationReasonUnspecified,
+	v1alpha1.RevocationReasonKeyCompromise,
+	v1alpha1.RevocationReasonCACompromise,
+	v1alpha1.RevocationReasonAffiliationChanged,
+	v1alpha1.RevocationReasonSuperseded,
+	v1alpha1.RevocationReasonCessationOfOperation,
+}
+
+type RevokeOptions struct {
+	*ClientOptions
+
+	Reason string
+
+	StdOut io.Writer
+	StdErr io.Writer
+}
+
+func (o *RevokeOptions) AddFlags(fs *pflag.FlagSet) {
+	var reasons []string
+	for _, r := range revocationReasons {
+		reasons = append(reasons, string(r))
+	}
+	fs.StringVar(&o.Reason, "reason", string(v1alpha1.RevocationReasonUnspecified), ""+
+		"The reason to revoke the certificates with. One of "+strings.Join(reasons, ", ")+".")
+}
+
+func (o *RevokeOptions) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("please supply one or more Certificate resource names")
+	}
+	for _, r := range revocationReasons {
+		if o.Reason == string(r) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown revocation reason %q", o.Reason)
+}
+
+// NewRevokeCommand returns a command that marks Certificates for revocation.
+func NewRevokeCommand(clientOpts *ClientOptions, out, errOut io.Writer) *cobra.Command {
+	o := &RevokeOptions{
+		ClientOptions: clientOpts,
+		StdOut:        out,
+		StdErr:        errOut,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "revoke certificate-name...",
+		Short: "Mark Certificates for manual revocation",
+		Long: `
+Mark one or more Certificates for revocation. The certificates controller will
+revoke the currently issued certificate with its issuer, recording the reason
+and time of the revocation in the Certificate's status, and then re-issue the
+Certificate. Revocation is supported by the ACME, Vault and Venafi issuers.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			cl, namespace, err := o.CMClient()
+			if err != nil {
+				return err
+			}
+			return o.Run(cl, namespace, args)
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// Run marks the named Certificates for revocation.
+func (o *RevokeOptions) Run(cl cmclient.Interface, namespace string, args []string) error {
+	var errs []error
+	for _, name := range args {
+		crt, err := cl.CertmanagerV1alpha1().Certificates(namespace).Get(name, metav1.GetOptions{})
+		if err == nil {
+			err = o.revokeCertificate(cl, crt)
+		}
+		if err != nil {
+			fmt.Fprintf(o.StdErr, "Failed to trigger revocation of Certificate %s/%s: %v\n", namespace, name, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (o *RevokeOptions) revokeCertificate(cl cmclient.Interface, crt *v1alpha1.Certificate) error {
+	crt = crt.DeepCopy()
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		apiutil.RequestCertificateRevocation(crt, v1alpha1.RevocationReason(o.Reason), messageRevocationTriggered)
+		_, err := cl.CertmanagerV1alpha1().Certificates(crt.Namespace).UpdateStatus(crt)
+		if !k8sErrors.IsConflict(err) {
+			return err
+		}
+		// the Certificate has been modified since it was read, so request
+		// the revocation again on the latest version of the resource
+		latest, getErr := cl.CertmanagerV1alpha1().Certificates(crt.Namespace).Get(crt.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		crt = latest
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.StdOut, "Manually triggered revocation of Certificate %s/%s with reason %s\n", crt.Namespace, crt.Name, o.Reason)
+	return nil
+}
@@ -18,18 +18,24 @@ package main
 
 import (
 	"flag"
+	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/openshift/generic-admission-server/pkg/cmd"
 	"k8s.io/klog"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation/webhooks"
+	"github.com/leki75/cert-manager/pkg/webhook/conversion"
 )
 
 var certHook cmd.ValidatingAdmissionHook = &webhooks.CertificateAdmissionHook{}
+var certRequestHook cmd.ValidatingAdmissionHook = &webhooks.CertificateRequestAdmissionHook{}
 var issuerHook cmd.ValidatingAdmissionHook = &webhooks.IssuerAdmissionHook{}
 var clusterIssuerHook cmd.ValidatingAdmissionHook = &webhooks.ClusterIssuerAdmissionHook{}
+var certPolicyHook cmd.ValidatingAdmissionHook = &webhooks.CertificatePolicyAdmissionHook{}
+var mutationHook cmd.MutatingAdmissionHook = webhooks.NewMutationAdmissionHook()
 
 func main() {
 	// Avoid "logging before flag.Parse" errors from glog
@@ -45,10 +51,23 @@ func main() {
 		runfilewatch(*tlsflagVal)
 	}
 
+	// the conversion webhook is served separately to the admission server,
+	// which does not accept any flags it does not know about, so the flag
+	// is removed from the arguments before they are parsed by
+	// cmd.RunAdmissionServer
+	conversionPort, args := extractFlag(os.Args[1:], "conversion-secure-port")
+	os.Args = append(os.Args[:1], args...)
+	if conversionPort != "" {
+		go runConversionServer(":"+conversionPort, lookupFlag(args, "tls-cert-file"), lookupFlag(args, "tls-private-key-file"))
+	}
+
 	cmd.RunAdmissionServer(
 		certHook,
+		certRequestHook,
 		issuerHook,
 		clusterIssuerHook,
+		certPolicyHook,
+		mutationHook,
 	)
 }
 
@@ -76,3 +95,46 @@ func runfilewatch(filename string) {
 		}
 	}()
 }
+
+// runConversionServer serves the CustomResourceDefinition conversion webhook
+// on the given address using the same serving certificate as the admission
+// server.
+func runConversionServer(addr, certFile, keyFile string) {
+	mux := http.NewServeMux()
+	mux.Handle("/convert", conversion.NewHandler())
+	server := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+	klog.Infof("Serving conversion webhook on %s", addr)
+	if err := server.ListenAndServeTLS(certFile, keyFile); err != nil {
+		klog.Fatalf("Error running conversion webhook server: %v", err)
+	}
+}
+
+// extractFlag returns the value of the named flag and the given arguments
+// with all occurrences of the flag removed.
+func extractFlag(args []string, name string) (string, []string) {
+	var val string
+	var rest []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		trimmed := strings.TrimLeft(arg, "-")
+		switch {
+		case trimmed == name && len(arg) > len(trimmed) && i+1 < len(args):
+			val = args[i+1]
+			i++
+		case strings.HasPrefix(trimmed, name+"=") && len(arg) > len(trimmed):
+			val = strings.TrimPrefix(trimmed, name+"=")
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	return val, rest
+}
+
+// lookupFlag returns the value of the named flag in the given arguments.
+func lookupFlag(args []string, name string) string {
+	val, _ := extractFlag(args, name)
+	return val
+}
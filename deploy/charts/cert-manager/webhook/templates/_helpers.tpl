@@ -46,3 +46,7 @@ Create chart name and version as used by the chart label.
 {{- define "webhook.servingCertificate" -}}
 {{ printf "%s-webhook-tls" (include "webhook.fullname" .) }}
 {{- end -}}
+
+{{- define "webhook.conversionService" -}}
+{{ printf "%s-conversion" (include "webhook.fullname" .) }}
+{{- end -}}
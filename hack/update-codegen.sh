@@ -36,4 +36,16 @@ generate-groups.sh "deepcopy,client,informer,lister" \
   --output-base "${GOPATH}/src/" \
   --go-header-file "${runfiles}/hack/boilerplate/boilerplate.go.txt"
 
+generate-groups.sh "deepcopy" \
+  github.com/jetstack/cert-manager/pkg/client github.com/jetstack/cert-manager/pkg/apis \
+  certmanager:v1alpha2 \
+  --output-base "${GOPATH}/src/" \
+  --go-header-file "${runfiles}/hack/boilerplate/boilerplate.go.txt"
+
+conversion-gen \
+  --input-dirs github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha1 \
+  -O zz_generated.conversion \
+  --output-base "${GOPATH}/src/" \
+  --go-header-file "${runfiles}/hack/boilerplate/boilerplate.go.txt"
+
 update-bazel.sh
@@ -18,8 +18,11 @@ package acme
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
@@ -40,7 +43,7 @@ import (
 
 type Helper interface {
 	ClientForIssuer(iss cmapi.GenericIssuer) (acme.Interface, error)
-	ReadPrivateKey(sel cmapi.SecretKeySelector, ns string) (*rsa.PrivateKey, error)
+	ReadPrivateKey(sel cmapi.SecretKeySelector, ns string) (crypto.Signer, error)
 }
 
 // Helper is a structure that provides 'glue' between cert-managers API types and
@@ -76,9 +79,8 @@ func PrivateKeySelector(sel cmapi.SecretKeySelector) cmapi.SecretKeySelector {
 // ReadPrivateKey will attempt to read and parse an ACME private key from a secret.
 // If the referenced secret or key within that secret does not exist, an error will
 // be returned.
-// A *rsa.PrivateKey will be returned here, as ACME private keys can currently
-// only be RSA.
-func (h *helperImpl) ReadPrivateKey(sel cmapi.SecretKeySelector, ns string) (*rsa.PrivateKey, error) {
+// Both RSA and ECDSA private keys are supported.
+func (h *helperImpl) ReadPrivateKey(sel cmapi.SecretKeySelector, ns string) (crypto.Signer, error) {
 	sel = PrivateKeySelector(sel)
 
 	s, err := h.SecretLister.Secrets(ns).Get(sel.Name)
@@ -97,22 +99,27 @@ func (h *helperImpl) ReadPrivateKey(sel cmapi.SecretKeySelector, ns string) (*rs
 		return nil, err
 	}
 
-	rsaKey, ok := pk.(*rsa.PrivateKey)
-	if !ok {
-		return nil, cmerrors.NewInvalidData("ACME private key in %q is not of type RSA", sel.Name)
+	switch pk := pk.(type) {
+	case *rsa.PrivateKey:
+		return pk, nil
+	case *ecdsa.PrivateKey:
+		return pk, nil
 	}
 
-	return rsaKey, nil
+	return nil, cmerrors.NewInvalidData("ACME private key in %q is not of type RSA or ECDSA", sel.Name)
 }
 
 // ClientWithKey will construct a new ACME client for the provided Issuer, using
-// the given RSA private key.
-func ClientWithKey(iss cmapi.GenericIssuer, pk *rsa.PrivateKey) (acme.Interface, error) {
+// the given RSA or ECDSA private key.
+func ClientWithKey(iss cmapi.GenericIssuer, pk crypto.Signer) (acme.Interface, error) {
 	acmeSpec := iss.GetSpec().ACME
 	if acmeSpec == nil {
 		return nil, fmt.Errorf("issuer %q is not an ACME issuer. Ensure the 'acme' stanza is correctly specified on your Issuer resource", iss.GetObjectMeta().Name)
 	}
-	acmeCl := lookupClient(acmeSpec, pk)
+	acmeCl, err := lookupClient(acmeSpec, pk)
+	if err != nil {
+		return nil, err
+	}
 
 	return acmemw.NewLogger(acmeCl), nil
 }
@@ -150,39 +157,43 @@ var (
 )
 
 type repoKey struct {
-	skiptls   bool
-	server    string
-	publickey string
-	exponent  int
+	skiptls        bool
+	server         string
+	preferredChain string
+	publickey      string
 }
 
-func lookupClient(spec *cmapi.ACMEIssuer, pk *rsa.PrivateKey) *acmecl.Client {
+func lookupClient(spec *cmapi.ACMEIssuer, pk crypto.Signer) (*acmecl.Client, error) {
+	pkbytes, err := x509.MarshalPKIXPublicKey(pk.Public())
+	if err != nil {
+		return nil, err
+	}
+
 	clientRepoMu.Lock()
 	defer clientRepoMu.Unlock()
 	if clientRepo == nil {
 		clientRepo = make(map[repoKey]*acmecl.Client)
 	}
 	repokey := repoKey{
-		skiptls: spec.SkipTLSVerify,
-		server:  spec.Server,
+		skiptls:        spec.SkipTLSVerify,
+		server:         spec.Server,
+		preferredChain: spec.PreferredChain,
+		publickey:      string(pkbytes),
 	}
-	// Encoding a big.Int cannot fail
-	pkbytes, _ := pk.PublicKey.N.GobEncode()
-	repokey.publickey = string(pkbytes)
-	repokey.exponent = pk.PublicKey.E
 
 	client := clientRepo[repokey]
 	if client != nil {
-		return client
+		return client, nil
 	}
 	acmeCl := &acmecl.Client{
-		HTTPClient:   buildHTTPClient(spec.SkipTLSVerify),
-		Key:          pk,
-		DirectoryURL: spec.Server,
-		UserAgent:    util.CertManagerUserAgent,
+		HTTPClient:     buildHTTPClient(spec.SkipTLSVerify),
+		Key:            pk,
+		DirectoryURL:   spec.Server,
+		UserAgent:      util.CertManagerUserAgent,
+		PreferredChain: spec.PreferredChain,
 	}
 	clientRepo[repokey] = acmeCl
-	return acmeCl
+	return acmeCl, nil
 }
 
 func ClearClientCache() {
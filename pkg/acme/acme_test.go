@@ -0,0 +1,138 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmerrors "github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+func TestReadPrivateKey(t *testing.T) {
+	rsaKey, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := pki.GenerateECPrivateKey(pki.ECCurve384)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey, err := pki.GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := func(pk crypto.PrivateKey) []byte {
+		b, err := pki.EncodePrivateKey(pk, cmapi.KeyEncoding(""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	tests := map[string]struct {
+		data        []byte
+		check       func(crypto.Signer) bool
+		invalidData bool
+	}{
+		"load an RSA private key": {
+			data: encode(rsaKey),
+			check: func(pk crypto.Signer) bool {
+				k, ok := pk.(*rsa.PrivateKey)
+				return ok && k.Equal(rsaKey)
+			},
+		},
+		"load an ECDSA private key": {
+			data: encode(ecKey),
+			check: func(pk crypto.Signer) bool {
+				k, ok := pk.(*ecdsa.PrivateKey)
+				return ok && k.Equal(ecKey)
+			},
+		},
+		"reject an Ed25519 private key": {
+			data:        encode(edKey),
+			invalidData: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			err := indexer.Add(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "account-key", Namespace: "default"},
+				Data:       map[string][]byte{corev1.TLSPrivateKeyKey: test.data},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := NewHelper(corelisters.NewSecretLister(indexer), "default")
+
+			pk, err := h.ReadPrivateKey(cmapi.SecretKeySelector{
+				LocalObjectReference: cmapi.LocalObjectReference{Name: "account-key"},
+			}, "default")
+			if test.invalidData {
+				if !cmerrors.IsInvalidData(err) {
+					t.Errorf("expected an invalid data error, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.check(pk) {
+				t.Errorf("unexpected private key returned: %T", pk)
+			}
+		})
+	}
+}
+
+func TestLookupClientPreferredChain(t *testing.T) {
+	defer ClearClientCache()
+
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &cmapi.ACMEIssuer{Server: "https://acme.example.com/directory"}
+
+	defaultCl, err := lookupClient(spec, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.PreferredChain = "Root B"
+	preferredCl, err := lookupClient(spec, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if defaultCl == preferredCl {
+		t.Errorf("expected a different client to be returned for a different preferred chain")
+	}
+	if preferredCl.PreferredChain != "Root B" {
+		t.Errorf("expected client preferred chain to be %q, got %q", "Root B", preferredCl.PreferredChain)
+	}
+}
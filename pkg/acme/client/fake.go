@@ -18,6 +18,8 @@ package client
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
 	"fmt"
 
 	"github.com/leki75/cert-manager/third_party/crypto/acme"
@@ -43,6 +45,8 @@ type FakeACME struct {
 	FakeDNS01ChallengeRecord    func(token string) (string, error)
 	FakeDiscover                func(ctx context.Context) (acme.Directory, error)
 	FakeUpdateAccount           func(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	FakeRevokeCert              func(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	FakeGetRenewalInfo          func(ctx context.Context, cert *x509.Certificate) (*acme.RenewalInfo, error)
 }
 
 func (f *FakeACME) CreateOrder(ctx context.Context, order *acme.Order) (*acme.Order, error) {
@@ -151,3 +155,17 @@ func (f *FakeACME) UpdateAccount(ctx context.Context, a *acme.Account) (*acme.Ac
 	}
 	return nil, fmt.Errorf("UpdateAccount not implemented")
 }
+
+func (f *FakeACME) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	if f.FakeRevokeCert != nil {
+		return f.FakeRevokeCert(ctx, key, cert, reason)
+	}
+	return fmt.Errorf("RevokeCert not implemented")
+}
+
+func (f *FakeACME) GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (*acme.RenewalInfo, error) {
+	if f.FakeGetRenewalInfo != nil {
+		return f.FakeGetRenewalInfo(ctx, cert)
+	}
+	return nil, fmt.Errorf("GetRenewalInfo not implemented")
+}
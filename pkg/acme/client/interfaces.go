@@ -18,6 +18,8 @@ package client
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
 
 	"github.com/leki75/cert-manager/third_party/crypto/acme"
 )
@@ -38,6 +40,8 @@ type Interface interface {
 	DNS01ChallengeRecord(token string) (string, error)
 	Discover(ctx context.Context) (acme.Directory, error)
 	UpdateAccount(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (*acme.RenewalInfo, error)
 }
 
 var _ Interface = &acme.Client{}
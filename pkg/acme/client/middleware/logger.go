@@ -18,6 +18,8 @@ package middleware
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
 
 	"k8s.io/klog"
 
@@ -108,3 +110,13 @@ func (l *Logger) UpdateAccount(ctx context.Context, a *acme.Account) (*acme.Acco
 	klog.Infof("Calling UpdateAccount")
 	return l.baseCl.UpdateAccount(ctx, a)
 }
+
+func (l *Logger) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	klog.Infof("Calling RevokeCert")
+	return l.baseCl.RevokeCert(ctx, key, cert, reason)
+}
+
+func (l *Logger) GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (*acme.RenewalInfo, error) {
+	klog.Infof("Calling GetRenewalInfo")
+	return l.baseCl.GetRenewalInfo(ctx, cert)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/util"
+)
+
+// ApprovalPolicyMatches returns true if the given ApprovalPolicy approves
+// the given CertificateRequest.
+func ApprovalPolicyMatches(p *cmapi.ApprovalPolicy, cr *cmapi.CertificateRequest) (bool, error) {
+	if issuerRefKind(p.Spec.IssuerRef) != issuerRefKind(cr.Spec.IssuerRef) || p.Spec.IssuerRef.Name != cr.Spec.IssuerRef.Name {
+		return false, nil
+	}
+
+	if len(p.Spec.Namespaces) > 0 && !util.Contains(p.Spec.Namespaces, cr.Namespace) {
+		return false, nil
+	}
+
+	if p.Spec.Selector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+
+	return selector.Matches(labels.Set(cr.Labels)), nil
+}
+
+func issuerRefKind(ref cmapi.ObjectReference) string {
+	if ref.Kind == "" {
+		return cmapi.IssuerKind
+	}
+	return ref.Kind
+}
@@ -154,6 +154,56 @@ func SetCertificateCondition(crt *cmapi.Certificate, conditionType cmapi.Certifi
 	klog.Infof("Setting lastTransitionTime for Certificate %q condition %q to %v", crt.Name, conditionType, nowTime.Time)
 }
 
+// RemoveCertificateCondition will remove any condition of the given type from
+// the given Certificate.
+func RemoveCertificateCondition(crt *cmapi.Certificate, conditionType cmapi.CertificateConditionType) {
+	var conditions []cmapi.CertificateCondition
+	for _, cond := range crt.Status.Conditions {
+		if cond.Type != conditionType {
+			conditions = append(conditions, cond)
+		}
+	}
+	crt.Status.Conditions = conditions
+}
+
+// CertificateRenewalRequested returns true if a renewal has been manually
+// requested for the given Certificate and has not yet been completed.
+func CertificateRenewalRequested(crt *cmapi.Certificate) bool {
+	return CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionRenewalRequested,
+		Status: cmapi.ConditionTrue,
+	})
+}
+
+// RequestCertificateRenewal marks the given Certificate for renewal by
+// setting the RenewalRequested condition and recording the time of the
+// request in the Certificate's status.
+// It will not actually submit the resource to the apiserver.
+func RequestCertificateRenewal(crt *cmapi.Certificate, reason, message string) {
+	nowTime := metav1.NewTime(Clock.Now())
+	crt.Status.LastRenewalRequestTime = &nowTime
+	SetCertificateCondition(crt, cmapi.CertificateConditionRenewalRequested, cmapi.ConditionTrue, reason, message)
+}
+
+// CertificateRevocationRequested returns the requested revocation reason and
+// true if a revocation has been manually requested for the given Certificate
+// and has not yet been completed.
+func CertificateRevocationRequested(crt *cmapi.Certificate) (cmapi.RevocationReason, bool) {
+	for _, cond := range crt.Status.Conditions {
+		if cond.Type == cmapi.CertificateConditionRevocationRequested && cond.Status == cmapi.ConditionTrue {
+			return cmapi.RevocationReason(cond.Reason), true
+		}
+	}
+	return "", false
+}
+
+// RequestCertificateRevocation marks the given Certificate for revocation by
+// setting the RevocationRequested condition with the given revocation reason.
+// It will not actually submit the resource to the apiserver.
+func RequestCertificateRevocation(crt *cmapi.Certificate, reason cmapi.RevocationReason, message string) {
+	SetCertificateCondition(crt, cmapi.CertificateConditionRevocationRequested, cmapi.ConditionTrue, string(reason), message)
+}
+
 // SetCertificateRequestCondition will set a 'condition' on the given CertificateRequest.
 // - If no condition of the same type already exists, the condition will be
 //   inserted with the LastTransitionTime set to the current time.
@@ -219,3 +269,21 @@ func CertificateRequestHasCondition(cr *cmapi.CertificateRequest, c cmapi.Certif
 	}
 	return false
 }
+
+// CertificateRequestIsApproved returns true if the given CertificateRequest
+// has been approved, and has not also been denied.
+func CertificateRequestIsApproved(cr *cmapi.CertificateRequest) bool {
+	return CertificateRequestHasCondition(cr, cmapi.CertificateRequestCondition{
+		Type:   cmapi.CertificateRequestConditionApproved,
+		Status: cmapi.ConditionTrue,
+	}) && !CertificateRequestIsDenied(cr)
+}
+
+// CertificateRequestIsDenied returns true if the given CertificateRequest
+// has been denied.
+func CertificateRequestIsDenied(cr *cmapi.CertificateRequest) bool {
+	return CertificateRequestHasCondition(cr, cmapi.CertificateRequestCondition{
+		Type:   cmapi.CertificateRequestConditionDenied,
+		Status: cmapi.ConditionTrue,
+	})
+}
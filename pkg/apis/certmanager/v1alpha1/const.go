@@ -30,8 +30,19 @@ const (
 
 	// Default duration before certificate expiration if  Issuer.spec.renewBefore is not set
 	DefaultRenewBefore = time.Hour * 24 * 30
+
+	// default RSA key size if Certificate.spec.keySize is not set
+	DefaultRSAKeySize = 2048
+
+	// default ECDSA key size if Certificate.spec.keySize is not set
+	DefaultECDSAKeySize = 256
 )
 
 const (
 	ACMEFinalizer = "finalizer.acme.cert-manager.io"
+
+	// RevocationFinalizer is added to Certificates with a RevocationPolicy of
+	// RevokeOnDelete so the issued certificate can be revoked before the
+	// Certificate is removed.
+	RevocationFinalizer = "finalizer.revocation.cert-manager.io"
 )
@@ -17,10 +17,211 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha2"
 )
 
+// DeprecatedConfigAnnotationKey is set on resources converted to v1alpha2
+// that contain deprecated configuration which has been removed from the
+// v1alpha2 API. It holds the JSON encoded deprecated configuration, which will
+// be restored when the resource is converted back to v1alpha1 in order for
+// conversions between the two versions to be lossless.
+const DeprecatedConfigAnnotationKey = "certmanager.k8s.io/v1alpha1-deprecated-config"
+
 func addConversionFuncs(scheme *runtime.Scheme) error {
 	// Add non-generated conversion functions
 	return scheme.AddConversionFuncs()
 }
+
+// acmeIssuerDeprecatedConfig holds the fields of an ACMEIssuer that have been
+// removed in v1alpha2.
+type acmeIssuerDeprecatedConfig struct {
+	HTTP01 *ACMEIssuerHTTP01Config `json:"http01,omitempty"`
+	DNS01  *ACMEIssuerDNS01Config  `json:"dns01,omitempty"`
+}
+
+// storeDeprecatedConfig will store the JSON encoding of cfg in the
+// DeprecatedConfigAnnotationKey annotation on the given ObjectMeta.
+func storeDeprecatedConfig(meta *metav1.ObjectMeta, cfg interface{}) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	// copy the annotations as they are shared with the resource being converted
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	annotations[DeprecatedConfigAnnotationKey] = string(data)
+	meta.Annotations = annotations
+	return nil
+}
+
+// restoreDeprecatedConfig will decode the DeprecatedConfigAnnotationKey
+// annotation on the given ObjectMeta into cfg, and remove the annotation.
+func restoreDeprecatedConfig(meta *metav1.ObjectMeta, cfg interface{}) error {
+	data, ok := meta.Annotations[DeprecatedConfigAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(data), cfg); err != nil {
+		return err
+	}
+	annotations := make(map[string]string, len(meta.Annotations)-1)
+	for k, v := range meta.Annotations {
+		if k != DeprecatedConfigAnnotationKey {
+			annotations[k] = v
+		}
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	meta.Annotations = annotations
+	return nil
+}
+
+func Convert_v1alpha1_Certificate_To_v1alpha2_Certificate(in *Certificate, out *v1alpha2.Certificate, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Certificate_To_v1alpha2_Certificate(in, out, s); err != nil {
+		return err
+	}
+	if in.Spec.ACME != nil {
+		return storeDeprecatedConfig(&out.ObjectMeta, in.Spec.ACME)
+	}
+	return nil
+}
+
+func Convert_v1alpha2_Certificate_To_v1alpha1_Certificate(in *v1alpha2.Certificate, out *Certificate, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Certificate_To_v1alpha1_Certificate(in, out, s); err != nil {
+		return err
+	}
+	if _, ok := in.Annotations[DeprecatedConfigAnnotationKey]; !ok {
+		return nil
+	}
+	out.Spec.ACME = &ACMECertificateConfig{}
+	return restoreDeprecatedConfig(&out.ObjectMeta, out.Spec.ACME)
+}
+
+// Convert_v1alpha1_CertificateSpec_To_v1alpha2_CertificateSpec drops the
+// deprecated 'acme' field, which is instead preserved by
+// Convert_v1alpha1_Certificate_To_v1alpha2_Certificate.
+func Convert_v1alpha1_CertificateSpec_To_v1alpha2_CertificateSpec(in *CertificateSpec, out *v1alpha2.CertificateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateSpec_To_v1alpha2_CertificateSpec(in, out, s)
+}
+
+func Convert_v1alpha1_Issuer_To_v1alpha2_Issuer(in *Issuer, out *v1alpha2.Issuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Issuer_To_v1alpha2_Issuer(in, out, s); err != nil {
+		return err
+	}
+	return storeACMEIssuerDeprecatedConfig(in.Spec.ACME, &out.ObjectMeta)
+}
+
+func Convert_v1alpha2_Issuer_To_v1alpha1_Issuer(in *v1alpha2.Issuer, out *Issuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Issuer_To_v1alpha1_Issuer(in, out, s); err != nil {
+		return err
+	}
+	return restoreACMEIssuerDeprecatedConfig(&out.ObjectMeta, out.Spec.ACME)
+}
+
+func Convert_v1alpha1_ClusterIssuer_To_v1alpha2_ClusterIssuer(in *ClusterIssuer, out *v1alpha2.ClusterIssuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ClusterIssuer_To_v1alpha2_ClusterIssuer(in, out, s); err != nil {
+		return err
+	}
+	return storeACMEIssuerDeprecatedConfig(in.Spec.ACME, &out.ObjectMeta)
+}
+
+func Convert_v1alpha2_ClusterIssuer_To_v1alpha1_ClusterIssuer(in *v1alpha2.ClusterIssuer, out *ClusterIssuer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_ClusterIssuer_To_v1alpha1_ClusterIssuer(in, out, s); err != nil {
+		return err
+	}
+	return restoreACMEIssuerDeprecatedConfig(&out.ObjectMeta, out.Spec.ACME)
+}
+
+func storeACMEIssuerDeprecatedConfig(in *ACMEIssuer, meta *metav1.ObjectMeta) error {
+	if in == nil || (in.HTTP01 == nil && in.DNS01 == nil) {
+		return nil
+	}
+	return storeDeprecatedConfig(meta, acmeIssuerDeprecatedConfig{
+		HTTP01: in.HTTP01,
+		DNS01:  in.DNS01,
+	})
+}
+
+func restoreACMEIssuerDeprecatedConfig(meta *metav1.ObjectMeta, out *ACMEIssuer) error {
+	cfg := acmeIssuerDeprecatedConfig{}
+	if err := restoreDeprecatedConfig(meta, &cfg); err != nil {
+		return err
+	}
+	// the issuer may no longer be an ACME issuer, in which case the
+	// deprecated configuration is discarded
+	if out == nil {
+		return nil
+	}
+	out.HTTP01 = cfg.HTTP01
+	out.DNS01 = cfg.DNS01
+	return nil
+}
+
+// Convert_v1alpha1_ACMEIssuer_To_v1alpha2_ACMEIssuer drops the deprecated
+// 'http01' and 'dns01' fields, which are instead preserved by
+// Convert_v1alpha1_Issuer_To_v1alpha2_Issuer and
+// Convert_v1alpha1_ClusterIssuer_To_v1alpha2_ClusterIssuer.
+func Convert_v1alpha1_ACMEIssuer_To_v1alpha2_ACMEIssuer(in *ACMEIssuer, out *v1alpha2.ACMEIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEIssuer_To_v1alpha2_ACMEIssuer(in, out, s)
+}
+
+func Convert_v1alpha1_Order_To_v1alpha2_Order(in *Order, out *v1alpha2.Order, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Order_To_v1alpha2_Order(in, out, s); err != nil {
+		return err
+	}
+	if len(in.Spec.Config) > 0 {
+		return storeDeprecatedConfig(&out.ObjectMeta, in.Spec.Config)
+	}
+	return nil
+}
+
+func Convert_v1alpha2_Order_To_v1alpha1_Order(in *v1alpha2.Order, out *Order, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Order_To_v1alpha1_Order(in, out, s); err != nil {
+		return err
+	}
+	return restoreDeprecatedConfig(&out.ObjectMeta, &out.Spec.Config)
+}
+
+// Convert_v1alpha1_OrderSpec_To_v1alpha2_OrderSpec drops the deprecated
+// 'config' field, which is instead preserved by
+// Convert_v1alpha1_Order_To_v1alpha2_Order.
+func Convert_v1alpha1_OrderSpec_To_v1alpha2_OrderSpec(in *OrderSpec, out *v1alpha2.OrderSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrderSpec_To_v1alpha2_OrderSpec(in, out, s)
+}
+
+func Convert_v1alpha1_Challenge_To_v1alpha2_Challenge(in *Challenge, out *v1alpha2.Challenge, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Challenge_To_v1alpha2_Challenge(in, out, s); err != nil {
+		return err
+	}
+	if in.Spec.Config != nil {
+		return storeDeprecatedConfig(&out.ObjectMeta, in.Spec.Config)
+	}
+	return nil
+}
+
+func Convert_v1alpha2_Challenge_To_v1alpha1_Challenge(in *v1alpha2.Challenge, out *Challenge, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Challenge_To_v1alpha1_Challenge(in, out, s); err != nil {
+		return err
+	}
+	if _, ok := in.Annotations[DeprecatedConfigAnnotationKey]; !ok {
+		return nil
+	}
+	out.Spec.Config = &SolverConfig{}
+	return restoreDeprecatedConfig(&out.ObjectMeta, out.Spec.Config)
+}
+
+// Convert_v1alpha1_ChallengeSpec_To_v1alpha2_ChallengeSpec drops the
+// deprecated 'config' field, which is instead preserved by
+// Convert_v1alpha1_Challenge_To_v1alpha2_Challenge.
+func Convert_v1alpha1_ChallengeSpec_To_v1alpha2_ChallengeSpec(in *ChallengeSpec, out *v1alpha2.ChallengeSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChallengeSpec_To_v1alpha2_ChallengeSpec(in, out, s)
+}
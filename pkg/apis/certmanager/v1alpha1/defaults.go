@@ -17,9 +17,54 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_Certificate sets the default values of any fields that have not
+// been set on the Certificate, so that the effective configuration of the
+// Certificate is visible on the resource.
+func SetDefaults_Certificate(obj *Certificate) {
+	if obj.Spec.IssuerRef.Kind == "" {
+		obj.Spec.IssuerRef.Kind = IssuerKind
+	}
+	if obj.Spec.KeyAlgorithm == "" {
+		obj.Spec.KeyAlgorithm = RSAKeyAlgorithm
+	}
+	if obj.Spec.KeySize == 0 {
+		switch obj.Spec.KeyAlgorithm {
+		case RSAKeyAlgorithm:
+			obj.Spec.KeySize = DefaultRSAKeySize
+		case ECDSAKeyAlgorithm:
+			obj.Spec.KeySize = DefaultECDSAKeySize
+		}
+	}
+	if obj.Spec.KeyEncoding == "" {
+		// ed25519 keys can only be encoded using PKCS#8
+		if obj.Spec.KeyAlgorithm == Ed25519KeyAlgorithm {
+			obj.Spec.KeyEncoding = PKCS8
+		} else {
+			obj.Spec.KeyEncoding = PKCS1
+		}
+	}
+	if obj.Spec.Duration == nil {
+		obj.Spec.Duration = &metav1.Duration{Duration: DefaultCertificateDuration}
+	}
+	// RenewBefore is not defaulted, as its default is resolved by the
+	// controller so that it follows the --renew-before-expiry-duration flag.
+}
+
+// SetDefaults_CertificateRequest sets the default values of any fields that
+// have not been set on the CertificateRequest.
+func SetDefaults_CertificateRequest(obj *CertificateRequest) {
+	if obj.Spec.IssuerRef.Kind == "" {
+		obj.Spec.IssuerRef.Kind = IssuerKind
+	}
+	if obj.Spec.Duration == nil {
+		obj.Spec.Duration = &metav1.Duration{Duration: DefaultCertificateDuration}
+	}
+}
@@ -61,6 +61,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&OrderList{},
 		&Challenge{},
 		&ChallengeList{},
+		&ApprovalPolicy{},
+		&ApprovalPolicyList{},
+		&CertificatePolicy{},
+		&CertificatePolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
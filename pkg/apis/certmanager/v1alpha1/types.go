@@ -19,10 +19,28 @@ package v1alpha1
 const (
 	AltNamesAnnotationKey   = "certmanager.k8s.io/alt-names"
 	IPSANAnnotationKey      = "certmanager.k8s.io/ip-sans"
+	URISANAnnotationKey     = "certmanager.k8s.io/uri-sans"
+	EmailSANAnnotationKey   = "certmanager.k8s.io/email-sans"
 	CommonNameAnnotationKey = "certmanager.k8s.io/common-name"
 	IssuerNameAnnotationKey = "certmanager.k8s.io/issuer-name"
 	IssuerKindAnnotationKey = "certmanager.k8s.io/issuer-kind"
+	DurationAnnotationKey   = "certmanager.k8s.io/duration"
 	CertificateNameKey      = "certmanager.k8s.io/certificate-name"
+
+	// CertificateRequestRevisionAnnotationKey is set on CertificateRequests
+	// created for a Certificate, and records the revision of the Certificate
+	// that the CertificateRequest was created for. It is also set on the
+	// target secret to record the revision of the stored certificate.
+	CertificateRequestRevisionAnnotationKey = "certmanager.k8s.io/certificate-revision"
+
+	// CRPrivateKeyAnnotationKey is set on CertificateRequests that reference
+	// a SelfSigned issuer, and holds the name of the Secret in the same
+	// namespace containing the private key to sign the request with.
+	CRPrivateKeyAnnotationKey = "certmanager.k8s.io/private-key-secret-name"
+
+	// DisableValidationLabelKey is set to "true" on namespaces whose
+	// resources are not validated by the cert-manager webhook.
+	DisableValidationLabelKey = "certmanager.k8s.io/disable-validation"
 )
 
 // ConditionStatus represents a condition's status.
@@ -64,6 +82,8 @@ const (
 	CertificateKind        = "Certificate"
 	CertificateRequestKind = "CertificateRequest"
 	OrderKind              = "Order"
+	ApprovalPolicyKind     = "ApprovalPolicy"
+	CertificatePolicyKind  = "CertificatePolicy"
 )
 
 type SecretKeySelector struct {
@@ -73,3 +93,40 @@ type SecretKeySelector struct {
 	// +optional
 	Key string `json:"key,omitempty"`
 }
+
+// KeyUsage specifies valid usage contexts for keys.
+// See: https://tools.ietf.org/html/rfc5280#section-4.2.1.3
+//
+//	https://tools.ietf.org/html/rfc5280#section-4.2.1.12
+type KeyUsage string
+
+const (
+	UsageSigning           KeyUsage = "signing"
+	UsageDigitalSignature  KeyUsage = "digital signature"
+	UsageContentCommitment KeyUsage = "content commitment"
+	UsageKeyEncipherment   KeyUsage = "key encipherment"
+	UsageKeyAgreement      KeyUsage = "key agreement"
+	UsageDataEncipherment  KeyUsage = "data encipherment"
+	UsageCertSign          KeyUsage = "cert sign"
+	UsageCRLSign           KeyUsage = "crl sign"
+	UsageEncipherOnly      KeyUsage = "encipher only"
+	UsageDecipherOnly      KeyUsage = "decipher only"
+	UsageAny               KeyUsage = "any"
+	UsageServerAuth        KeyUsage = "server auth"
+	UsageClientAuth        KeyUsage = "client auth"
+	UsageCodeSigning       KeyUsage = "code signing"
+	UsageEmailProtection   KeyUsage = "email protection"
+	UsageSMIME             KeyUsage = "s/mime"
+	UsageIPsecEndSystem    KeyUsage = "ipsec end system"
+	UsageIPsecTunnel       KeyUsage = "ipsec tunnel"
+	UsageIPsecUser         KeyUsage = "ipsec user"
+	UsageTimestamping      KeyUsage = "timestamping"
+	UsageOCSPSigning       KeyUsage = "ocsp signing"
+	UsageMicrosoftSGC      KeyUsage = "microsoft sgc"
+	UsageNetscapeSGC       KeyUsage = "netscape sgc"
+)
+
+// DefaultKeyUsages contains the default list of key usages
+func DefaultKeyUsages() []KeyUsage {
+	return []KeyUsage{UsageDigitalSignature, UsageKeyEncipherment}
+}
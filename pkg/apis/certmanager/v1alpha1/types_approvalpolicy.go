@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ApprovalPolicy automatically approves the CertificateRequests that it
+// matches. CertificateRequests that are not matched by any ApprovalPolicy
+// must be approved or denied manually.
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=".spec.issuerRef.name",description=""
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+// +kubebuilder:resource:path=approvalpolicies,scope=Cluster
+type ApprovalPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ApprovalPolicySpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ApprovalPolicyList is a list of ApprovalPolicies
+type ApprovalPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ApprovalPolicy `json:"items"`
+}
+
+// ApprovalPolicySpec defines which CertificateRequests are approved by an
+// ApprovalPolicy.
+type ApprovalPolicySpec struct {
+	// IssuerRef is a reference to the issuer whose CertificateRequests are
+	// approved by this policy. If the 'kind' field is not set, or set to
+	// 'Issuer', the policy applies to Issuers with the given name in any of
+	// the namespaces matched by the policy.  If the 'kind' field is set to
+	// 'ClusterIssuer', the policy applies to the ClusterIssuer with the
+	// given name.
+	IssuerRef ObjectReference `json:"issuerRef"`
+
+	// Namespaces is the list of namespaces that CertificateRequests are
+	// approved in. If not set, CertificateRequests in all namespaces are
+	// approved.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Selector is a label selector for the CertificateRequests that are
+	// approved. If not set, all CertificateRequests are approved.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
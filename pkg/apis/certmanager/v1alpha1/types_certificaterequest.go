@@ -30,6 +30,7 @@ import (
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type=="Ready")].message",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 // +kubebuilder:resource:path=certificaterequests,shortName=cr;crs
+// +kubebuilder:subresource:status
 type CertificateRequest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -70,6 +71,16 @@ type CertificateRequestSpec struct {
 	// implies that the 'signing' usage is set
 	// +optional
 	IsCA bool `json:"isCA,omitempty"`
+
+	// Usages is the set of x509 key usages and extended key usages to be
+	// set on the resulting certificate.
+	// If not set, the usages requested in the CSR will be used, and if the
+	// CSR does not request any, 'digital signature', 'key encipherment',
+	// 'server auth' and 'client auth'.
+	// The Vault issuer ignores this field, as the usages of certificates it
+	// signs are controlled by the Vault role.
+	// +optional
+	Usages []KeyUsage `json:"usages,omitempty"`
 }
 
 // CertificateStatus defines the observed state of CertificateRequest and
@@ -91,7 +102,7 @@ type CertificateRequestStatus struct {
 
 // CertificateRequestCondition contains condition information for a CertificateRequest.
 type CertificateRequestCondition struct {
-	// Type of the condition, currently ('Ready').
+	// Type of the condition, currently ('Ready', 'Approved', 'Denied').
 	Type CertificateRequestConditionType `json:"type"`
 
 	// Status of the condition, one of ('True', 'False', 'Unknown').
@@ -122,4 +133,26 @@ const (
 	// This is defined as:
 	// - The target certificate exists in CertificateRequest.Status
 	CertificateRequestConditionReady CertificateRequestConditionType = "Ready"
+
+	// CertificateRequestConditionApproved indicates that the request has been
+	// approved, and may be signed by its issuer. Once set, the condition
+	// cannot be removed or modified.
+	CertificateRequestConditionApproved CertificateRequestConditionType = "Approved"
+
+	// CertificateRequestConditionDenied indicates that the request has been
+	// denied, and will never be signed. Once set, the condition cannot be
+	// removed or modified.
+	CertificateRequestConditionDenied CertificateRequestConditionType = "Denied"
+)
+
+const (
+	// CertificateRequestReasonFailed is the reason set on the Ready condition
+	// of a CertificateRequest that has permanently failed, and will not be
+	// processed any further.
+	CertificateRequestReasonFailed = "CertFailed"
+
+	// CertificateRequestReasonDenied is the reason set on the Ready condition
+	// of a CertificateRequest that has been denied, and will not be
+	// processed any further.
+	CertificateRequestReasonDenied = "Denied"
 )
@@ -28,6 +28,7 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // +kubebuilder:resource:path=clusterissuers
+// +kubebuilder:subresource:status
 type ClusterIssuer struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -51,6 +52,7 @@ type ClusterIssuerList struct {
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // +kubebuilder:resource:path=issuers
+// +kubebuilder:subresource:status
 type Issuer struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -73,6 +75,26 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// NamespaceSelector restricts the namespaces that Certificates and
+	// CertificateRequests may reference this issuer from. It may only be set
+	// on ClusterIssuers. If not set, the issuer may be referenced from all
+	// namespaces.
+	// +optional
+	NamespaceSelector *IssuerNamespaceSelector `json:"namespaceSelector,omitempty"`
+}
+
+// IssuerNamespaceSelector selects the namespaces that a ClusterIssuer may be
+// referenced from. A namespace is selected if it is named in MatchNames, or
+// if its labels match Selector.
+type IssuerNamespaceSelector struct {
+	// MatchNames is the list of names of the selected namespaces.
+	// +optional
+	MatchNames []string `json:"matchNames,omitempty"`
+
+	// Selector is a label selector for the selected namespaces.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
 type IssuerConfig struct {
@@ -201,10 +223,37 @@ type ACMEIssuer struct {
 	// +optional
 	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
 
+	// PreferredChain is the common name of a root or intermediate certificate
+	// that issued certificates should chain to. If the ACME server offers
+	// an alternate chain matching this name it will be used instead of the
+	// default chain. If no chain matches, the default chain is used.
+	// +optional
+	PreferredChain string `json:"preferredChain,omitempty"`
+
 	// PrivateKey is the name of a secret containing the private key for this
 	// user account.
 	PrivateKey SecretKeySelector `json:"privateKeySecretRef"`
 
+	// PrivateKeyAlgorithm is the algorithm of the private key generated for
+	// this user account if the PrivateKey secret does not exist. One of "rsa"
+	// or "ecdsa". Defaults to "rsa". Existing private keys of either
+	// algorithm are always used as-is.
+	// +optional
+	PrivateKeyAlgorithm KeyAlgorithm `json:"privateKeyAlgorithm,omitempty"`
+
+	// PrivateKeySize is the size of the private key generated for this user
+	// account. For "rsa" keys it must be between 2048 and 8192 inclusive,
+	// defaulting to 2048. For "ecdsa" keys it is the curve size, either 256
+	// or 384, defaulting to 256.
+	// +optional
+	PrivateKeySize int `json:"privateKeySize,omitempty"`
+
+	// ExternalAccountBinding contains the credentials used to bind the ACME
+	// account to an existing account with the CA. This is required by some
+	// ACME servers in order to register a new account.
+	// +optional
+	ExternalAccountBinding *ACMEExternalAccountBinding `json:"externalAccountBinding,omitempty"`
+
 	// Solvers is a list of challenge solvers that will be used to solve
 	// ACME challenges for the matching domains.
 	// +optional
@@ -219,6 +268,33 @@ type ACMEIssuer struct {
 	DNS01 *ACMEIssuerDNS01Config `json:"dns01,omitempty"`
 }
 
+// ACMEExternalAccountBinding is a reference to an account held with the CA
+// outside of the ACME protocol.
+type ACMEExternalAccountBinding struct {
+	// KeyID is the identifier of the MAC key provided by the CA.
+	KeyID string `json:"keyID"`
+
+	// Key is a reference to a key in a Secret containing the MAC key provided
+	// by the CA. The key data must be base64url encoded, as provided by
+	// most CAs.
+	Key SecretKeySelector `json:"keySecretRef"`
+
+	// KeyAlgorithm is the MAC algorithm used to sign the binding. One of
+	// HS256, HS384 or HS512. Defaults to HS256.
+	// +optional
+	KeyAlgorithm HMACKeyAlgorithm `json:"keyAlgorithm,omitempty"`
+}
+
+// HMACKeyAlgorithm is the MAC algorithm used to sign an external account
+// binding.
+type HMACKeyAlgorithm string
+
+const (
+	HS256 HMACKeyAlgorithm = "HS256"
+	HS384 HMACKeyAlgorithm = "HS384"
+	HS512 HMACKeyAlgorithm = "HS512"
+)
+
 type ACMEChallengeSolver struct {
 	// Selector selects a set of DNSNames on the Certificate resource that
 	// should be solved using this challenge solver.
@@ -229,6 +305,9 @@ type ACMEChallengeSolver struct {
 
 	// +optional
 	DNS01 *ACMEChallengeSolverDNS01 `json:"dns01,omitempty"`
+
+	// +optional
+	TLSALPN01 *ACMEChallengeSolverTLSALPN01 `json:"tlsalpn01,omitempty"`
 }
 
 // CertificateDomainSelector selects certificates using a label selector, and
@@ -334,6 +413,31 @@ type ACMEChallengeSolverHTTP01IngressPodSpec struct {
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 }
 
+// ACMEChallengeSolverTLSALPN01 configures the TLS-ALPN-01 challenge solver.
+// Challenges are solved by 'challenge solver' pods that are provisioned by
+// cert-manager for each Challenge to be completed, and which answer TLS
+// connections negotiating the 'acme-tls/1' protocol. A Service is created to
+// route connections on port 443 to each pod.
+type ACMEChallengeSolverTLSALPN01 struct {
+	// Optional service type for Kubernetes solver service. Defaults to
+	// NodePort.
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// Optional fixed node port to expose the solver service on, so that a
+	// TCP load balancer can route connections on port 443 to it.
+	// Only valid if the service type is NodePort or LoadBalancer. As a node
+	// port can only be allocated to one service at a time, challenges using
+	// this solver are solved one after another.
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
+
+	// Optional pod template used to configure the ACME challenge solver pods
+	// used for TLS-ALPN-01 challenges
+	// +optional
+	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate,omitempty"`
+}
+
 type ACMEChallengeSolverDNS01 struct {
 	// CNAMEStrategy configures how the DNS01 provider should handle CNAME
 	// records when found in DNS zones.
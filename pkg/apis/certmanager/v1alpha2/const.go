@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import "time"
+
+const (
+	// minimum permitted certificate duration by cert-manager
+	MinimumCertificateDuration = time.Hour
+
+	// default certificate duration if Issuer.spec.duration is not set
+	DefaultCertificateDuration = time.Hour * 24 * 90
+
+	// minimum certificate duration before certificate expiration
+	MinimumRenewBefore = time.Minute * 5
+
+	// Default duration before certificate expiration if  Issuer.spec.renewBefore is not set
+	DefaultRenewBefore = time.Hour * 24 * 30
+
+	// default RSA key size if Certificate.spec.keySize is not set
+	DefaultRSAKeySize = 2048
+
+	// default ECDSA key size if Certificate.spec.keySize is not set
+	DefaultECDSAKeySize = 256
+)
+
+const (
+	ACMEFinalizer = "finalizer.acme.cert-manager.io"
+
+	// RevocationFinalizer is added to Certificates with a RevocationPolicy of
+	// RevokeOnDelete so the issued certificate can be revoked before the
+	// Certificate is removed.
+	RevocationFinalizer = "finalizer.revocation.cert-manager.io"
+)
@@ -0,0 +1,530 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Certificate is a type to represent a Certificate from ACME
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=="Ready")].status",description=""
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description=""
+// +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=".spec.issuerRef.name",description="",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type=="Ready")].message",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+// +kubebuilder:resource:path=certificates,shortName=cert;certs
+// +kubebuilder:subresource:status
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec,omitempty"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateList is a list of Certificates
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Certificate `json:"items"`
+}
+
+type KeyAlgorithm string
+
+const (
+	RSAKeyAlgorithm     KeyAlgorithm = "rsa"
+	ECDSAKeyAlgorithm   KeyAlgorithm = "ecdsa"
+	Ed25519KeyAlgorithm KeyAlgorithm = "ed25519"
+)
+
+type KeyEncoding string
+
+const (
+	PKCS1 KeyEncoding = "pkcs1"
+	PKCS8 KeyEncoding = "pkcs8"
+)
+
+// KeyRotationPolicy denotes whether a new private key should be generated
+// when a Certificate is re-issued.
+type KeyRotationPolicy string
+
+const (
+	// KeyRotationPolicyNever will cause the private key stored in the target
+	// Secret to be reused for each issuance.
+	// A new private key is only generated if one does not exist or it cannot
+	// be decoded.
+	KeyRotationPolicyNever KeyRotationPolicy = "Never"
+
+	// KeyRotationPolicyAlways will cause a new private key to be generated
+	// each time the Certificate is issued or renewed.
+	KeyRotationPolicyAlways KeyRotationPolicy = "Always"
+)
+
+// RevocationPolicy denotes whether the certificate issued for a Certificate
+// should be revoked when the Certificate is deleted.
+type RevocationPolicy string
+
+const (
+	// RevocationPolicyNever will cause the issued certificate to be left
+	// valid when the Certificate is deleted.
+	RevocationPolicyNever RevocationPolicy = "Never"
+
+	// RevocationPolicyRevokeOnDelete will cause the issued certificate to be
+	// revoked with the issuer when the Certificate is deleted.
+	RevocationPolicyRevokeOnDelete RevocationPolicy = "RevokeOnDelete"
+)
+
+// RevocationReason is the reason a certificate is revoked, as defined in
+// RFC 5280 section 5.3.1.
+type RevocationReason string
+
+const (
+	RevocationReasonUnspecified          RevocationReason = "Unspecified"
+	RevocationReasonKeyCompromise        RevocationReason = "KeyCompromise"
+	RevocationReasonCACompromise         RevocationReason = "CACompromise"
+	RevocationReasonAffiliationChanged   RevocationReason = "AffiliationChanged"
+	RevocationReasonSuperseded           RevocationReason = "Superseded"
+	RevocationReasonCessationOfOperation RevocationReason = "CessationOfOperation"
+)
+
+// CertificateSpec defines the desired state of Certificate
+type CertificateSpec struct {
+	// CommonName is a common name to be used on the Certificate.
+	// If no CommonName is given, then the first entry in DNSNames is used as
+	// the CommonName.
+	// The CommonName should have a length of 64 characters or fewer to avoid
+	// generating invalid CSRs; in order to have longer domain names, set the
+	// CommonName (or first DNSNames entry) to have 64 characters or fewer,
+	// and then add the longer domain name to DNSNames.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Organization is the organization to be used on the Certificate
+	// +optional
+	Organization []string `json:"organization,omitempty"`
+
+	// Subject contains the remaining X.509 distinguished name fields to be
+	// used on the Certificate. The CommonName and Organization fields are
+	// configured separately.
+	// Issuers such as Vault may override the requested subject, in which
+	// case changes to it will not cause the certificate to be re-issued.
+	// +optional
+	Subject *X509Subject `json:"subject,omitempty"`
+
+	// Certificate default Duration
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Certificate renew before expiration duration
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// DNSNames is a list of subject alt names to be used on the Certificate.
+	// If no CommonName is given, then the first entry in DNSNames is used as
+	// the CommonName and must have a length of 64 characters or fewer.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPAddresses is a list of IP addresses to be used on the Certificate
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// URISANs is a list of URI Subject Alternative Names to be used on the
+	// Certificate, for example SPIFFE IDs (spiffe://trust-domain/workload).
+	// +optional
+	URISANs []string `json:"uriSANs,omitempty"`
+
+	// EmailSANs is a list of email address Subject Alternative Names to be
+	// used on the Certificate.
+	// +optional
+	EmailSANs []string `json:"emailSANs,omitempty"`
+
+	// SecretName is the name of the secret resource to store this secret in
+	SecretName string `json:"secretName"`
+
+	// SecretTemplate defines annotations and labels to be copied to the
+	// Secret named by SecretName. The template is reconciled on every sync.
+	// Keys removed from the template are not removed from the Secret, so
+	// that metadata managed by other controllers is never clobbered.
+	// +optional
+	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
+
+	// IssuerRef is a reference to the issuer for this certificate.
+	// If the 'kind' field is not set, or set to 'Issuer', an Issuer resource
+	// with the given name in the same namespace as the Certificate will be used.
+	// If the 'kind' field is set to 'ClusterIssuer', a ClusterIssuer with the
+	// provided name will be used.
+	// The 'name' field in this stanza is required at all times.
+	IssuerRef ObjectReference `json:"issuerRef"`
+
+	// IsCA will mark this Certificate as valid for signing.
+	// This implies that the 'signing' usage is set
+	// +optional
+	IsCA bool `json:"isCA,omitempty"`
+
+	// KeySize is the key bit size of the corresponding private key for this certificate.
+	// If provided, value must be between 2048 and 8192 inclusive when KeyAlgorithm is
+	// empty or is set to "rsa", and value must be one of (256, 384, 521) when
+	// KeyAlgorithm is set to "ecdsa". It must not be set when KeyAlgorithm is
+	// set to "ed25519".
+	// +optional
+	KeySize int `json:"keySize,omitempty"`
+
+	// KeyAlgorithm is the private key algorithm of the corresponding private key
+	// for this certificate. If provided, allowed values are either "rsa", "ecdsa"
+	// or "ed25519".
+	// If KeyAlgorithm is specified and KeySize is not provided,
+	// key size of 256 will be used for "ecdsa" key algorithm and
+	// key size of 2048 will be used for "rsa" key algorithm.
+	// +kubebuilder:validation:Enum=rsa,ecdsa,ed25519
+	// +optional
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+
+	// KeyEncoding is the private key cryptography standards (PKCS)
+	// for this certificate's private key to be encoded in. If provided, allowed
+	// values are "pkcs1" and "pkcs8" standing for PKCS#1 and PKCS#8, respectively.
+	// If KeyEncoding is not specified, then PKCS#1 will be used by default.
+	// Ed25519 private keys can only be encoded using PKCS#8, which will be
+	// used by default for the "ed25519" key algorithm.
+	KeyEncoding KeyEncoding `json:"keyEncoding,omitempty"`
+
+	// KeyRotationPolicy controls how the private key for this certificate is
+	// managed when the certificate is re-issued. If provided, allowed values
+	// are "Never" and "Always". If "Always", a new private key will be
+	// generated each time the certificate is issued or renewed. If "Never",
+	// the existing private key stored in the Secret will be reused.
+	// If not specified, the Venafi issuer will generate a new private key for
+	// each issuance and all other issuers will reuse the existing private key.
+	// Whilst a certificate for a newly generated private key is being issued,
+	// the key is stored in the 'tls-next.key' entry of the Secret and the
+	// existing certificate and private key are left in place.
+	// +kubebuilder:validation:Enum=Never,Always
+	// +optional
+	KeyRotationPolicy KeyRotationPolicy `json:"keyRotationPolicy,omitempty"`
+
+	// Usages is the set of x509 key usages and extended key usages to be
+	// requested for this Certificate.
+	// If not set, 'digital signature' and 'key encipherment' will be used.
+	// 'cert sign' will additionally be set if IsCA is true.
+	// Vault issuers only support the default usages, as the usages of
+	// certificates they sign are controlled by the Vault role.
+	// +optional
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// Keystores configures additional keystore output formats stored in the
+	// Secret named by SecretName alongside the PEM encoded certificate and
+	// private key.
+	// +optional
+	Keystores *CertificateKeystores `json:"keystores,omitempty"`
+
+	// RevisionHistoryLimit is the maximum number of CertificateRequest
+	// revisions that are maintained in the Certificate's history. Each
+	// revision represents a single CertificateRequest created by this
+	// Certificate. Older revisions are garbage collected once the limit is
+	// exceeded. If not set, no revisions will be garbage collected.
+	// Only used when the CertificateRequestControllers feature gate is
+	// enabled.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// RevocationPolicy controls whether the issued certificate is revoked
+	// with the issuer when this Certificate is deleted. If provided, allowed
+	// values are "Never" and "RevokeOnDelete". Defaults to "Never".
+	// Revocation is supported by the ACME, Vault and Venafi issuers.
+	// +kubebuilder:validation:Enum=Never,RevokeOnDelete
+	// +optional
+	RevocationPolicy RevocationPolicy `json:"revocationPolicy,omitempty"`
+}
+
+const (
+	// PKCS12SecretKey is the name of the data entry in Secret resources used
+	// to store a PKCS#12 keystore containing the certificate and private key.
+	PKCS12SecretKey = "keystore.p12"
+
+	// JKSSecretKey is the name of the data entry in Secret resources used to
+	// store a JKS keystore containing the certificate and private key.
+	JKSSecretKey = "keystore.jks"
+
+	// JKSTruststoreKey is the name of the data entry in Secret resources used
+	// to store a JKS truststore containing the CA certificates.
+	JKSTruststoreKey = "truststore.jks"
+
+	// NextPrivateKeySecretKey is the name of the data entry in Secret
+	// resources used to store a newly generated private key whilst a
+	// certificate for it is being issued. It replaces the existing private
+	// key only once the new certificate has been stored.
+	NextPrivateKeySecretKey = "tls-next.key"
+)
+
+// CertificateKeystores configures additional keystore output formats to be
+// created in the Certificate's output Secret.
+type CertificateKeystores struct {
+	// JKS configures options for storing a JKS keystore and truststore in
+	// the target Secret resource.
+	// +optional
+	JKS *JKSKeystore `json:"jks,omitempty"`
+
+	// PKCS12 configures options for storing a PKCS#12 keystore in the target
+	// Secret resource.
+	// +optional
+	PKCS12 *PKCS12Keystore `json:"pkcs12,omitempty"`
+}
+
+// JKSKeystore configures options for storing a JKS keystore in the target
+// Secret resource.
+type JKSKeystore struct {
+	// Create enables JKS keystore creation for the Certificate.
+	// If true, a file named `keystore.jks` will be created in the target
+	// Secret resource, encrypted using the password stored in
+	// passwordSecretRef. A `truststore.jks` file containing each of the CA
+	// certificates will also be created if the issuer returned a CA.
+	Create bool `json:"create"`
+
+	// PasswordSecretRef is a reference to a key in a Secret resource
+	// containing the password used to encrypt the JKS keystore.
+	PasswordSecretRef SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// PKCS12Keystore configures options for storing a PKCS#12 keystore in the
+// target Secret resource.
+type PKCS12Keystore struct {
+	// Create enables PKCS#12 keystore creation for the Certificate.
+	// If true, a file named `keystore.p12` will be created in the target
+	// Secret resource, encrypted using the password stored in
+	// passwordSecretRef.
+	Create bool `json:"create"`
+
+	// PasswordSecretRef is a reference to a key in a Secret resource
+	// containing the password used to encrypt the PKCS#12 keystore.
+	PasswordSecretRef SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// CertificateSecretTemplate defines the default labels and annotations
+// to be copied to the Kubernetes Secret resource named in a Certificate.
+type CertificateSecretTemplate struct {
+	// Annotations is a key value map to be copied to the target Kubernetes
+	// Secret.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels is a key value map to be copied to the target Kubernetes Secret.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// X509Subject contains the X.509 distinguished name fields that may be set on
+// a Certificate in addition to its CommonName and Organization.
+type X509Subject struct {
+	// Countries to be used on the Certificate.
+	// +optional
+	Countries []string `json:"countries,omitempty"`
+
+	// Organizational Units to be used on the Certificate.
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+
+	// Cities to be used on the Certificate.
+	// +optional
+	Localities []string `json:"localities,omitempty"`
+
+	// State/Provinces to be used on the Certificate.
+	// +optional
+	Provinces []string `json:"provinces,omitempty"`
+
+	// Street addresses to be used on the Certificate.
+	// +optional
+	StreetAddresses []string `json:"streetAddresses,omitempty"`
+
+	// Postal codes to be used on the Certificate.
+	// +optional
+	PostalCodes []string `json:"postalCodes,omitempty"`
+
+	// Serial number to be used on the Certificate.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// CertificateStatus defines the observed state of Certificate
+type CertificateStatus struct {
+	// +optional
+	Conditions []CertificateCondition `json:"conditions,omitempty"`
+
+	// LastFailureTime is the time at which the ACME issuer last observed a
+	// failed Order for the certificate. It is used by the ACME issuer to
+	// delay creating a new Order, and is cleared whenever one is created.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// LastIssuanceFailureTime is the time at which an attempt to issue the
+	// certificate last failed. It is used to apply an exponential back-off
+	// to subsequent issuance attempts, and is cleared once a certificate has
+	// been issued successfully.
+	// It is separate from LastFailureTime, as the ACME issuer clears that
+	// field each time it retries an Order, which would reset the back-off.
+	// +optional
+	LastIssuanceFailureTime *metav1.Time `json:"lastIssuanceFailureTime,omitempty"`
+
+	// LastIssuanceFailureGeneration is the metadata.generation of the
+	// certificate when an attempt to issue it last failed. Any back-off is
+	// reset once the certificate's spec has been changed.
+	// +optional
+	LastIssuanceFailureGeneration *int64 `json:"lastIssuanceFailureGeneration,omitempty"`
+
+	// FailedIssuanceAttempts is the number of consecutive failed attempts to
+	// issue the certificate. It is reset once a certificate has been issued
+	// successfully, or the certificate's spec has been changed.
+	// +optional
+	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+
+	// LastRenewalRequestTime is the time at which a renewal of the
+	// certificate was last manually requested.
+	// +optional
+	LastRenewalRequestTime *metav1.Time `json:"lastRenewalRequestTime,omitempty"`
+
+	// The expiration time of the certificate stored in the secret named
+	// by this resource in spec.secretName.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// The time from which the certificate stored in the secret named by this
+	// resource in spec.secretName is valid.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// RenewalTime is the time at which the certificate stored in the secret
+	// named by this resource in spec.secretName will be renewed.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+
+	// The current 'revision' of the certificate as issued.
+	// When a CertificateRequest resource is created, it will have the
+	// 'certmanager.k8s.io/certificate-revision' annotation set to one greater
+	// than the current value of this field. Upon issuance, this field will be
+	// set to the value of the annotation on the CertificateRequest that was
+	// used to issue the certificate.
+	// +optional
+	Revision *int `json:"revision,omitempty"`
+
+	// Revocation records the most recent revocation of a certificate issued
+	// for this resource.
+	// +optional
+	Revocation *CertificateRevocation `json:"revocation,omitempty"`
+
+	// RenewalInfo records the renewal window suggested by the issuer for the
+	// certificate stored in the secret named by this resource in
+	// spec.secretName. It is only set for certificates issued by an ACME
+	// server that supports the ACME Renewal Information (ARI) extension.
+	// +optional
+	RenewalInfo *CertificateRenewalInfo `json:"renewalInfo,omitempty"`
+}
+
+// CertificateRenewalInfo records the window in which the issuer suggests a
+// certificate should be renewed.
+type CertificateRenewalInfo struct {
+	// SerialNumber is the hex encoded serial number of the certificate the
+	// suggested window applies to.
+	SerialNumber string `json:"serialNumber"`
+
+	// SuggestedWindowStart is the start of the suggested renewal window.
+	SuggestedWindowStart metav1.Time `json:"suggestedWindowStart"`
+
+	// SuggestedWindowEnd is the end of the suggested renewal window.
+	SuggestedWindowEnd metav1.Time `json:"suggestedWindowEnd"`
+
+	// ExplanationURL optionally locates a page explaining why the suggested
+	// window has been chosen, for example following a mass revocation event.
+	// +optional
+	ExplanationURL string `json:"explanationURL,omitempty"`
+
+	// NextCheckTime is the time after which the renewal information will be
+	// requested from the issuer again.
+	NextCheckTime metav1.Time `json:"nextCheckTime"`
+}
+
+// CertificateRevocation records the revocation of a certificate.
+type CertificateRevocation struct {
+	// SerialNumber is the hex encoded serial number of the revoked
+	// certificate.
+	SerialNumber string `json:"serialNumber"`
+
+	// Reason is the reason the certificate was revoked.
+	Reason RevocationReason `json:"reason"`
+
+	// RevocationTime is the time at which the certificate was revoked.
+	RevocationTime metav1.Time `json:"revocationTime"`
+}
+
+// CertificateCondition contains condition information for an Certificate.
+type CertificateCondition struct {
+	// Type of the condition, currently ('Ready').
+	Type CertificateConditionType `json:"type"`
+
+	// Status of the condition, one of ('True', 'False', 'Unknown').
+	// +kubebuilder:validation:Enum=True,False,Unknown
+	Status ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CertificateConditionType represents an Certificate condition value.
+type CertificateConditionType string
+
+const (
+	// CertificateConditionReady indicates that a certificate is ready for use.
+	// This is defined as:
+	// - The target secret exists
+	// - The target secret contains a certificate that has not expired
+	// - The target secret contains a private key valid for the certificate
+	// - The commonName, dnsNames, ipAddresses, uriSANs and emailSANs attributes
+	//   match those specified on the Certificate
+	// - The private key algorithm and size, organization, subject, isCA,
+	//   usages, duration and issuer match those specified on the Certificate
+	CertificateConditionReady CertificateConditionType = "Ready"
+
+	// CertificateConditionRenewalRequested indicates that a renewal of the
+	// certificate has been manually requested, for example using the
+	// 'kubectl cert-manager renew' command.
+	// The certificates controller will re-issue the certificate and remove
+	// this condition once a new certificate has been stored in the Secret.
+	CertificateConditionRenewalRequested CertificateConditionType = "RenewalRequested"
+
+	// CertificateConditionRevocationRequested indicates that a revocation of
+	// the certificate has been manually requested, for example using the
+	// 'kubectl cert-manager revoke' command. The reason of the condition is
+	// the RevocationReason to revoke the certificate with.
+	// The certificates controller will revoke and then re-issue the
+	// certificate, and remove this condition once it has been revoked.
+	CertificateConditionRevocationRequested CertificateConditionType = "RevocationRequested"
+)
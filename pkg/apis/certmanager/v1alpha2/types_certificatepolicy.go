@@ -0,0 +1,113 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificatePolicy restricts the certificates that may be requested from
+// the issuers it selects. Certificates and CertificateRequests must satisfy
+// all of the CertificatePolicies that select them.
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+// +kubebuilder:resource:path=certificatepolicies,scope=Cluster
+type CertificatePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CertificatePolicySpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificatePolicyList is a list of CertificatePolicies
+type CertificatePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CertificatePolicy `json:"items"`
+}
+
+// CertificatePolicySpec defines the certificates permitted by a
+// CertificatePolicy. Fields that are not set do not restrict the
+// certificates that may be requested.
+type CertificatePolicySpec struct {
+	// Selector selects the Certificates and CertificateRequests that this
+	// policy applies to.
+	// +optional
+	Selector CertificatePolicySelector `json:"selector,omitempty"`
+
+	// AllowedDNSNames is the list of DNS names that may be requested,
+	// including the common name. A '*' label in a pattern matches any single
+	// label, so '*.example.com' permits 'www.example.com' and
+	// '*.example.com', but not 'example.com' or 'a.b.example.com'.
+	// +optional
+	AllowedDNSNames []string `json:"allowedDNSNames,omitempty"`
+
+	// AllowedIPRanges is the list of IP ranges, in CIDR notation, that
+	// requested IP addresses must be contained in.
+	// +optional
+	AllowedIPRanges []string `json:"allowedIPRanges,omitempty"`
+
+	// AllowedPrivateKeys is the list of private key algorithms, and
+	// optionally their sizes, that may be used.
+	// +optional
+	AllowedPrivateKeys []CertificatePolicyPrivateKey `json:"allowedPrivateKeys,omitempty"`
+
+	// MaxDuration is the maximum duration that may be requested.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// AllowCA permits CA certificates to be requested. If false, requests
+	// with isCA set are not permitted.
+	// +optional
+	AllowCA bool `json:"allowCA,omitempty"`
+}
+
+// CertificatePolicySelector selects the Certificates and CertificateRequests
+// that a CertificatePolicy applies to. A resource is selected if it matches
+// all of the fields that are set.
+type CertificatePolicySelector struct {
+	// IssuerRefs is the list of issuers the policy applies to. If the 'kind'
+	// field of a reference is not set, or set to 'Issuer', it matches Issuers
+	// with the given name in any namespace. If not set, the policy applies to
+	// all issuers.
+	// +optional
+	IssuerRefs []ObjectReference `json:"issuerRefs,omitempty"`
+
+	// Namespaces is the list of namespaces the policy applies to. If not
+	// set, the policy applies to all namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// CertificatePolicyPrivateKey is a private key algorithm permitted by a
+// CertificatePolicy.
+type CertificatePolicyPrivateKey struct {
+	// Algorithm is the permitted private key algorithm.
+	Algorithm KeyAlgorithm `json:"algorithm"`
+
+	// Sizes is the list of permitted key sizes. If not set, any size is
+	// permitted. Sizes must not be set for the 'ed25519' algorithm.
+	// +optional
+	Sizes []int `json:"sizes,omitempty"`
+}
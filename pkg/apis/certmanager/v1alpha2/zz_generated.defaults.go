@@ -0,0 +1,58 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was autogenerated by defaulter-gen. Do not edit it manually!
+
+package v1alpha2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&Certificate{}, func(obj interface{}) { SetObjectDefaults_Certificate(obj.(*Certificate)) })
+	scheme.AddTypeDefaultingFunc(&CertificateList{}, func(obj interface{}) { SetObjectDefaults_CertificateList(obj.(*CertificateList)) })
+	scheme.AddTypeDefaultingFunc(&CertificateRequest{}, func(obj interface{}) { SetObjectDefaults_CertificateRequest(obj.(*CertificateRequest)) })
+	scheme.AddTypeDefaultingFunc(&CertificateRequestList{}, func(obj interface{}) { SetObjectDefaults_CertificateRequestList(obj.(*CertificateRequestList)) })
+	return nil
+}
+
+func SetObjectDefaults_Certificate(in *Certificate) {
+	SetDefaults_Certificate(in)
+}
+
+func SetObjectDefaults_CertificateList(in *CertificateList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_Certificate(a)
+	}
+}
+
+func SetObjectDefaults_CertificateRequest(in *CertificateRequest) {
+	SetDefaults_CertificateRequest(in)
+}
+
+func SetObjectDefaults_CertificateRequestList(in *CertificateRequestList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_CertificateRequest(a)
+	}
+}
@@ -19,10 +19,17 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/mail"
+	"net/url"
+	"strings"
 
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"github.com/leki75/cert-manager/pkg/apis/certmanager"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager v1alpha1 Certificate types
@@ -35,8 +42,8 @@ func ValidateCertificateSpec(crt *v1alpha1.CertificateSpec, fldPath *field.Path)
 
 	el = append(el, validateIssuerRef(crt.IssuerRef, fldPath)...)
 
-	if len(crt.CommonName) == 0 && len(crt.DNSNames) == 0 {
-		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one dnsName is required if commonName is not set"))
+	if len(crt.CommonName) == 0 && len(crt.DNSNames) == 0 && len(crt.IPAddresses) == 0 && len(crt.URISANs) == 0 && len(crt.EmailSANs) == 0 {
+		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one of commonName, dnsNames, ipAddresses, uriSANs or emailSANs must be set"))
 	}
 	// if a common name has been specified, ensure it is no longer than 64 chars
 	if len(crt.CommonName) > 64 {
@@ -51,6 +58,12 @@ func ValidateCertificateSpec(crt *v1alpha1.CertificateSpec, fldPath *field.Path)
 	if len(crt.IPAddresses) > 0 {
 		el = append(el, validateIPAddresses(crt, fldPath)...)
 	}
+	if len(crt.URISANs) > 0 {
+		el = append(el, validateURISANs(crt, fldPath)...)
+	}
+	if len(crt.EmailSANs) > 0 {
+		el = append(el, validateEmailSANs(crt, fldPath)...)
+	}
 	if crt.ACME != nil {
 		el = append(el, validateACMEConfigForAllDNSNames(crt, fldPath)...)
 		el = append(el, ValidateACMECertificateConfig(crt.ACME, fldPath.Child("acme"))...)
@@ -68,8 +81,15 @@ func ValidateCertificateSpec(crt *v1alpha1.CertificateSpec, fldPath *field.Path)
 		if crt.KeySize > 0 && crt.KeySize != 256 && crt.KeySize != 384 && crt.KeySize != 521 {
 			el = append(el, field.NotSupported(fldPath.Child("keySize"), crt.KeySize, []string{"256", "384", "521"}))
 		}
+	case v1alpha1.Ed25519KeyAlgorithm:
+		if crt.KeySize != 0 {
+			el = append(el, field.Invalid(fldPath.Child("keySize"), crt.KeySize, "must not be set for ed25519 keyAlgorithm"))
+		}
+		if crt.KeyEncoding == v1alpha1.PKCS1 {
+			el = append(el, field.Invalid(fldPath.Child("keyEncoding"), crt.KeyEncoding, "ed25519 keys must be encoded using pkcs8"))
+		}
 	default:
-		el = append(el, field.Invalid(fldPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "must be either empty or one of rsa or ecdsa"))
+		el = append(el, field.Invalid(fldPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
 	}
 
 	if crt.Duration != nil || crt.RenewBefore != nil {
@@ -81,6 +101,78 @@ func ValidateCertificateSpec(crt *v1alpha1.CertificateSpec, fldPath *field.Path)
 	default:
 		el = append(el, field.Invalid(fldPath.Child("keyEncoding"), crt.KeyEncoding, "must be either empty or one of pkcs1 or pkcs8"))
 	}
+
+	switch crt.KeyRotationPolicy {
+	case v1alpha1.KeyRotationPolicy(""), v1alpha1.KeyRotationPolicyNever, v1alpha1.KeyRotationPolicyAlways:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("keyRotationPolicy"), crt.KeyRotationPolicy, "must be either empty or one of Never or Always"))
+	}
+
+	switch crt.RevocationPolicy {
+	case v1alpha1.RevocationPolicy(""), v1alpha1.RevocationPolicyNever, v1alpha1.RevocationPolicyRevokeOnDelete:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("revocationPolicy"), crt.RevocationPolicy, "must be either empty or one of Never or RevokeOnDelete"))
+	}
+
+	if len(crt.Usages) > 0 {
+		el = append(el, validateUsages(crt.Usages, fldPath)...)
+	}
+	if crt.Keystores != nil {
+		el = append(el, validateKeystores(crt, fldPath.Child("keystores"))...)
+	}
+	if crt.SecretTemplate != nil {
+		el = append(el, validateSecretTemplate(crt.SecretTemplate, fldPath.Child("secretTemplate"))...)
+	}
+	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
+		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
+	}
+	return el
+}
+
+// validateSecretTemplate ensures the labels and annotations in the template
+// are valid, and that they do not overwrite metadata managed by cert-manager.
+func validateSecretTemplate(tmpl *v1alpha1.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	el = append(el, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
+	el = append(el, apivalidation.ValidateAnnotations(tmpl.Annotations, fldPath.Child("annotations"))...)
+	for k := range tmpl.Labels {
+		if strings.HasPrefix(k, certmanager.GroupName+"/") {
+			el = append(el, field.Invalid(fldPath.Child("labels"), k, "cert-manager labels cannot be set in the secret template"))
+		}
+	}
+	for k := range tmpl.Annotations {
+		if strings.HasPrefix(k, certmanager.GroupName+"/") {
+			el = append(el, field.Invalid(fldPath.Child("annotations"), k, "cert-manager annotations cannot be set in the secret template"))
+		}
+	}
+	return el
+}
+
+func validateKeystores(crt *v1alpha1.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	create := false
+	if crt.Keystores.JKS != nil && crt.Keystores.JKS.Create {
+		create = true
+		el = append(el, validateKeystorePasswordSecretRef(crt.Keystores.JKS.PasswordSecretRef, fldPath.Child("jks", "passwordSecretRef"))...)
+	}
+	if crt.Keystores.PKCS12 != nil && crt.Keystores.PKCS12.Create {
+		create = true
+		el = append(el, validateKeystorePasswordSecretRef(crt.Keystores.PKCS12.PasswordSecretRef, fldPath.Child("pkcs12", "passwordSecretRef"))...)
+	}
+	if create && crt.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+		el = append(el, field.Invalid(fldPath, crt.KeyAlgorithm, "keystores cannot be created for ed25519 private keys"))
+	}
+	return el
+}
+
+func validateKeystorePasswordSecretRef(ref v1alpha1.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if ref.Name == "" {
+		el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+	}
+	if ref.Key == "" {
+		el = append(el, field.Required(fldPath.Child("key"), "must be specified"))
+	}
 	return el
 }
 
@@ -147,6 +239,49 @@ func validateIPAddresses(a *v1alpha1.CertificateSpec, fldPath *field.Path) field
 	return el
 }
 
+func validateURISANs(a *v1alpha1.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, d := range a.URISANs {
+		uri, err := url.Parse(d)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("uriSANs").Index(i), d, fmt.Sprintf("invalid URI: %s", err)))
+			continue
+		}
+		if !uri.IsAbs() {
+			el = append(el, field.Invalid(fldPath.Child("uriSANs").Index(i), d, "URI must be absolute"))
+		}
+	}
+	return el
+}
+
+func validateEmailSANs(a *v1alpha1.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, d := range a.EmailSANs {
+		addr, err := mail.ParseAddress(d)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("emailSANs").Index(i), d, fmt.Sprintf("invalid email address: %s", err)))
+			continue
+		}
+		// only bare addresses are permitted, i.e. not 'Name <user@example.com>'
+		if addr.Address != d {
+			el = append(el, field.Invalid(fldPath.Child("emailSANs").Index(i), d, "invalid email address: must not include a display name"))
+		}
+	}
+	return el
+}
+
+func validateUsages(usages []v1alpha1.KeyUsage, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, u := range usages {
+		_, isKU := pki.KeyUsageType(u)
+		_, isEKU := pki.ExtKeyUsageType(u)
+		if !isKU && !isEKU {
+			el = append(el, field.Invalid(fldPath.Child("usages").Index(i), u, "unknown keyusage"))
+		}
+	}
+	return el
+}
+
 func ValidateACMECertificateConfig(a *v1alpha1.ACMECertificateConfig, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	for i, cfg := range a.Config {
@@ -50,6 +50,39 @@ func ValidateCertificateForIssuer(crt *v1alpha1.Certificate, issuerObj v1alpha1.
 	return el
 }
 
+// tlsKeyUsages is the set of usages that can be requested from issuers that
+// only produce general purpose TLS certificates.
+var tlsKeyUsages = []v1alpha1.KeyUsage{
+	v1alpha1.UsageSigning,
+	v1alpha1.UsageDigitalSignature,
+	v1alpha1.UsageKeyEncipherment,
+	v1alpha1.UsageServerAuth,
+	v1alpha1.UsageClientAuth,
+}
+
+// validateUsagesSupported ensures that each of the given usages is contained
+// in the list of supported usages.
+func validateUsagesSupported(usages []v1alpha1.KeyUsage, supported []v1alpha1.KeyUsage, specPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	var supportedStr []string
+	for _, s := range supported {
+		supportedStr = append(supportedStr, string(s))
+	}
+
+Outer:
+	for i, u := range usages {
+		for _, s := range supported {
+			if u == s {
+				continue Outer
+			}
+		}
+		el = append(el, field.NotSupported(specPath.Child("usages").Index(i), u, supportedStr))
+	}
+
+	return el
+}
+
 func ValidateCertificateForACMEIssuer(crt *v1alpha1.CertificateSpec, issuer *v1alpha1.IssuerSpec, specPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -61,14 +94,32 @@ func ValidateCertificateForACMEIssuer(crt *v1alpha1.CertificateSpec, issuer *v1a
 		el = append(el, field.Invalid(specPath.Child("organization"), crt.Organization, "ACME does not support setting the organization name"))
 	}
 
+	if crt.Subject != nil {
+		el = append(el, field.Invalid(specPath.Child("subject"), crt.Subject, "ACME does not support setting the certificate subject"))
+	}
+
 	if crt.Duration != nil {
 		el = append(el, field.Invalid(specPath.Child("duration"), crt.Duration, "ACME does not support certificate durations"))
 	}
 
+	if crt.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+		el = append(el, field.Invalid(specPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "ACME does not support ed25519 private keys"))
+	}
+
 	if len(crt.IPAddresses) != 0 {
 		el = append(el, field.Invalid(specPath.Child("ipAddresses"), crt.IPAddresses, "ACME does not support certificate ip addresses"))
 	}
 
+	if len(crt.URISANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("uriSANs"), crt.URISANs, "ACME does not support certificate uri SANs"))
+	}
+
+	if len(crt.EmailSANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("emailSANs"), crt.EmailSANs, "ACME does not support certificate email SANs"))
+	}
+
+	el = append(el, validateUsagesSupported(crt.Usages, tlsKeyUsages, specPath)...)
+
 	return el
 }
 
@@ -89,9 +140,32 @@ func ValidateCertificateForVaultIssuer(crt *v1alpha1.CertificateSpec, issuer *v1
 		el = append(el, field.Invalid(specPath.Child("organization"), crt.Organization, "Vault issuer does not currently support setting the organization name"))
 	}
 
+	// the usages of certificates signed by Vault are set by the Vault role
+	if len(crt.Usages) != 0 && !usagesEqual(crt.Usages, v1alpha1.DefaultKeyUsages()) {
+		el = append(el, field.Invalid(specPath.Child("usages"), crt.Usages, "Vault issuer does not currently support setting key usages other than the defaults"))
+	}
+
 	return el
 }
 
+// usagesEqual returns true if both lists contain the same usages, in any
+// order.
+func usagesEqual(a, b []v1alpha1.KeyUsage) bool {
+	set := make(map[v1alpha1.KeyUsage]bool, len(a))
+	for _, u := range a {
+		set[u] = true
+	}
+	if len(set) != len(b) {
+		return false
+	}
+	for _, u := range b {
+		if !set[u] {
+			return false
+		}
+	}
+	return true
+}
+
 func ValidateCertificateForSelfSignedIssuer(crt *v1alpha1.CertificateSpec, issuer *v1alpha1.IssuerSpec, specPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -101,5 +175,15 @@ func ValidateCertificateForSelfSignedIssuer(crt *v1alpha1.CertificateSpec, issue
 func ValidateCertificateForVenafiIssuer(crt *v1alpha1.CertificateSpec, issuer *v1alpha1.IssuerSpec, specPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
+	if len(crt.URISANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("uriSANs"), crt.URISANs, "Venafi issuer does not currently support setting uri SANs"))
+	}
+
+	if crt.KeyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+		el = append(el, field.Invalid(specPath.Child("keyAlgorithm"), crt.KeyAlgorithm, "Venafi issuer does not currently support ed25519 private keys"))
+	}
+
+	el = append(el, validateUsagesSupported(crt.Usages, tlsKeyUsages, specPath)...)
+
 	return el
 }
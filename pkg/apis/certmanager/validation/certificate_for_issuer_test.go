@@ -108,6 +108,31 @@ func TestValidateCertificateForIssuer(t *testing.T) {
 				Namespace: defaultTestNamespace,
 			}),
 		},
+		"certificate with Ed25519 keyAlgorithm for ACME": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+					IssuerRef:    validIssuerRef,
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{
+								Domains: []string{"example.com"},
+								SolverConfig: v1alpha1.SolverConfig{
+									HTTP01: &v1alpha1.HTTP01SolverConfig{},
+								},
+							},
+						},
+					},
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keyAlgorithm"), v1alpha1.Ed25519KeyAlgorithm, "ACME does not support ed25519 private keys"),
+			},
+		},
 		"acme certificate with organization set": {
 			crt: &v1alpha1.Certificate{
 				Spec: v1alpha1.CertificateSpec{
@@ -133,6 +158,31 @@ func TestValidateCertificateForIssuer(t *testing.T) {
 				field.Invalid(fldPath.Child("organization"), []string{"shouldfailorg"}, "ACME does not support setting the organization name"),
 			},
 		},
+		"acme certificate with subject set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Subject:   &v1alpha1.X509Subject{Countries: []string{"GB"}},
+					IssuerRef: validIssuerRef,
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{
+								Domains: []string{"example.com"},
+								SolverConfig: v1alpha1.SolverConfig{
+									HTTP01: &v1alpha1.HTTP01SolverConfig{},
+								},
+							},
+						},
+					},
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("subject"), &v1alpha1.X509Subject{Countries: []string{"GB"}}, "ACME does not support setting the certificate subject"),
+			},
+		},
 		"acme certificate with duration set": {
 			crt: &v1alpha1.Certificate{
 				Spec: v1alpha1.CertificateSpec{
@@ -183,6 +233,116 @@ func TestValidateCertificateForIssuer(t *testing.T) {
 				field.Invalid(fldPath.Child("ipAddresses"), []string{"127.0.0.1"}, "ACME does not support certificate ip addresses"),
 			},
 		},
+		"acme certificate with uriSANs set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					URISANs:   []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+					IssuerRef: validIssuerRef,
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{
+								Domains: []string{"example.com"},
+								SolverConfig: v1alpha1.SolverConfig{
+									HTTP01: &v1alpha1.HTTP01SolverConfig{},
+								},
+							},
+						},
+					},
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("uriSANs"), []string{"spiffe://cluster.local/ns/sandbox/sa/foo"}, "ACME does not support certificate uri SANs"),
+			},
+		},
+		"acme certificate with emailSANs set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					EmailSANs: []string{"alice@example.com"},
+					IssuerRef: validIssuerRef,
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{
+								Domains: []string{"example.com"},
+								SolverConfig: v1alpha1.SolverConfig{
+									HTTP01: &v1alpha1.HTTP01SolverConfig{},
+								},
+							},
+						},
+					},
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("emailSANs"), []string{"alice@example.com"}, "ACME does not support certificate email SANs"),
+			},
+		},
+		"acme certificate with code signing usage set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Usages:    []v1alpha1.KeyUsage{v1alpha1.UsageServerAuth, v1alpha1.UsageCodeSigning},
+					IssuerRef: validIssuerRef,
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{
+								Domains: []string{"example.com"},
+								SolverConfig: v1alpha1.SolverConfig{
+									HTTP01: &v1alpha1.HTTP01SolverConfig{},
+								},
+							},
+						},
+					},
+				},
+			},
+			issuer: generate.Issuer(generate.IssuerConfig{
+				Name:      defaultTestIssuerName,
+				Namespace: defaultTestNamespace,
+			}),
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("usages").Index(1), v1alpha1.UsageCodeSigning,
+					[]string{"signing", "digital signature", "key encipherment", "server auth", "client auth"}),
+			},
+		},
+		"vault certificate with the default usages set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Usages:    []v1alpha1.KeyUsage{v1alpha1.UsageKeyEncipherment, v1alpha1.UsageDigitalSignature},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{
+						Vault: &v1alpha1.VaultIssuer{},
+					},
+				},
+			},
+		},
+		"vault certificate with server auth usage set": {
+			crt: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					Usages:    []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageServerAuth},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{
+						Vault: &v1alpha1.VaultIssuer{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages"), []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageServerAuth},
+					"Vault issuer does not currently support setting key usages other than the defaults"),
+			},
+		},
 		"acme certificate with renewBefore set": {
 			crt: &v1alpha1.Certificate{
 				Spec: v1alpha1.CertificateSpec{
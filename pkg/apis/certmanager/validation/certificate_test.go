@@ -39,6 +39,10 @@ func strPtr(s string) *string {
 	return &s
 }
 
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
 func TestValidateCertificate(t *testing.T) {
 	fldPath := field.NewPath("spec")
 	scenarios := map[string]struct {
@@ -121,7 +125,7 @@ func TestValidateCertificate(t *testing.T) {
 				},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("dnsNames"), "at least one dnsName is required if commonName is not set"),
+				field.Required(fldPath.Child("dnsNames"), "at least one of commonName, dnsNames, ipAddresses, uriSANs or emailSANs must be set"),
 			},
 		},
 		"certificate with no issuerRef": {
@@ -144,6 +148,213 @@ func TestValidateCertificate(t *testing.T) {
 				},
 			},
 		},
+		"valid certificate with only uriSANs": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					URISANs:    []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+		},
+		"valid certificate with only emailSANs": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					EmailSANs:  []string{"alice@example.com"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+		},
+		"certificate with relative uriSAN": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					URISANs:    []string{"/ns/sandbox"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("uriSANs").Index(0), "/ns/sandbox", "URI must be absolute"),
+			},
+		},
+		"certificate with emailSAN containing a display name": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					EmailSANs:  []string{"Alice <alice@example.com>"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("emailSANs").Index(0), "Alice <alice@example.com>", "invalid email address: must not include a display name"),
+			},
+		},
+		"certificate with valid usages": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Usages:     []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageClientAuth},
+				},
+			},
+		},
+		"certificate with unknown usage": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Usages:     []v1alpha1.KeyUsage{"nonsense"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(0), v1alpha1.KeyUsage("nonsense"), "unknown keyusage"),
+			},
+		},
+		"certificate with keystores": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &v1alpha1.CertificateKeystores{
+						JKS: &v1alpha1.JKSKeystore{
+							Create: true,
+							PasswordSecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "password"},
+								Key:                  "jks",
+							},
+						},
+						PKCS12: &v1alpha1.PKCS12Keystore{
+							Create: true,
+							PasswordSecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "password"},
+								Key:                  "pkcs12",
+							},
+						},
+					},
+				},
+			},
+		},
+		"certificate with keystores missing password references": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &v1alpha1.CertificateKeystores{
+						JKS: &v1alpha1.JKSKeystore{
+							Create: true,
+						},
+						PKCS12: &v1alpha1.PKCS12Keystore{
+							Create: true,
+							PasswordSecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "password"},
+							},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("keystores", "jks", "passwordSecretRef", "name"), "must be specified"),
+				field.Required(fldPath.Child("keystores", "jks", "passwordSecretRef", "key"), "must be specified"),
+				field.Required(fldPath.Child("keystores", "pkcs12", "passwordSecretRef", "key"), "must be specified"),
+			},
+		},
+		"certificate with keystores and ed25519 key algorithm": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+					Keystores: &v1alpha1.CertificateKeystores{
+						PKCS12: &v1alpha1.PKCS12Keystore{
+							Create: true,
+							PasswordSecretRef: v1alpha1.SecretKeySelector{
+								LocalObjectReference: v1alpha1.LocalObjectReference{Name: "password"},
+								Key:                  "pkcs12",
+							},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keystores"), v1alpha1.Ed25519KeyAlgorithm, "keystores cannot be created for ed25519 private keys"),
+			},
+		},
+		"certificate with secret template": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &v1alpha1.CertificateSecretTemplate{
+						Labels:      map[string]string{"app.kubernetes.io/part-of": "example"},
+						Annotations: map[string]string{"example.com/annotation": "value"},
+					},
+				},
+			},
+		},
+		"certificate with secret template overriding cert-manager metadata": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &v1alpha1.CertificateSecretTemplate{
+						Labels:      map[string]string{v1alpha1.CertificateNameKey: "other"},
+						Annotations: map[string]string{v1alpha1.IssuerNameAnnotationKey: "other"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "labels"), v1alpha1.CertificateNameKey, "cert-manager labels cannot be set in the secret template"),
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), v1alpha1.IssuerNameAnnotationKey, "cert-manager annotations cannot be set in the secret template"),
+			},
+		},
+		"certificate with secret template with invalid label value": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &v1alpha1.CertificateSecretTemplate{
+						Labels: map[string]string{"example": "not a valid value"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "labels"), "not a valid value", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
+		},
+		"certificate with revision history limit": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:           "testcn",
+					SecretName:           "abc",
+					IssuerRef:            validIssuerRef,
+					RevisionHistoryLimit: int32Ptr(1),
+				},
+			},
+		},
+		"certificate with invalid revision history limit": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:           "testcn",
+					SecretName:           "abc",
+					IssuerRef:            validIssuerRef,
+					RevisionHistoryLimit: int32Ptr(0),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
+			},
+		},
 		"valid acme certificate": {
 			cfg: &v1alpha1.Certificate{
 				Spec: v1alpha1.CertificateSpec{
@@ -369,7 +580,91 @@ func TestValidateCertificate(t *testing.T) {
 				},
 			},
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("keyAlgorithm"), v1alpha1.KeyAlgorithm("blah"), "must be either empty or one of rsa or ecdsa"),
+				field.Invalid(fldPath.Child("keyAlgorithm"), v1alpha1.KeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa or ed25519"),
+			},
+		},
+		"valid certificate with keyRotationPolicy Always": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:        "testcn",
+					SecretName:        "abc",
+					IssuerRef:         validIssuerRef,
+					KeyRotationPolicy: v1alpha1.KeyRotationPolicyAlways,
+				},
+			},
+		},
+		"certificate with invalid keyRotationPolicy": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:        "testcn",
+					SecretName:        "abc",
+					IssuerRef:         validIssuerRef,
+					KeyRotationPolicy: v1alpha1.KeyRotationPolicy("Sometimes"),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keyRotationPolicy"), v1alpha1.KeyRotationPolicy("Sometimes"), "must be either empty or one of Never or Always"),
+			},
+		},
+		"valid certificate with revocationPolicy RevokeOnDelete": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:       "testcn",
+					SecretName:       "abc",
+					IssuerRef:        validIssuerRef,
+					RevocationPolicy: v1alpha1.RevocationPolicyRevokeOnDelete,
+				},
+			},
+		},
+		"certificate with invalid revocationPolicy": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:       "testcn",
+					SecretName:       "abc",
+					IssuerRef:        validIssuerRef,
+					RevocationPolicy: v1alpha1.RevocationPolicy("Always"),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("revocationPolicy"), v1alpha1.RevocationPolicy("Always"), "must be either empty or one of Never or RevokeOnDelete"),
+			},
+		},
+		"valid certificate with ed25519 keyAlgorithm": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+				},
+			},
+		},
+		"certificate with ed25519 keyAlgorithm and keySize set": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+					KeySize:      256,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keySize"), 256, "must not be set for ed25519 keyAlgorithm"),
+			},
+		},
+		"certificate with ed25519 keyAlgorithm and pkcs1 keyEncoding": {
+			cfg: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+					KeyEncoding:  v1alpha1.PKCS1,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keyEncoding"), v1alpha1.PKCS1, "ed25519 keys must be encoded using pkcs8"),
 			},
 		},
 		"valid certificate with ipAddresses": {
@@ -474,6 +769,31 @@ func TestValidateCertificate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDefaultedCertificate(t *testing.T) {
+	scenarios := map[string]v1alpha1.KeyAlgorithm{
+		"rsa certificate":     v1alpha1.RSAKeyAlgorithm,
+		"ecdsa certificate":   v1alpha1.ECDSAKeyAlgorithm,
+		"ed25519 certificate": v1alpha1.Ed25519KeyAlgorithm,
+	}
+	for n, keyAlgorithm := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			crt := &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					CommonName:   "testcn",
+					SecretName:   "abc",
+					IssuerRef:    validIssuerRef,
+					KeyAlgorithm: keyAlgorithm,
+				},
+			}
+			v1alpha1.SetObjectDefaults_Certificate(crt)
+			if errs := ValidateCertificate(crt); len(errs) > 0 {
+				t.Errorf("Expected defaulted certificate to be valid, but got %v", errs)
+			}
+		})
+	}
+}
+
 func TestValidateACMECertificateConfig(t *testing.T) {
 	fldPath := field.NewPath("")
 	scenarios := map[string]struct {
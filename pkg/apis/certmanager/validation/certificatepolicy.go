@@ -0,0 +1,318 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/util"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+func ValidateCertificatePolicy(p *v1alpha1.CertificatePolicy) field.ErrorList {
+	allErrs := ValidateCertificatePolicySpec(&p.Spec, field.NewPath("spec"))
+	return allErrs
+}
+
+func ValidateCertificatePolicySpec(spec *v1alpha1.CertificatePolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for i, ref := range spec.Selector.IssuerRefs {
+		refPath := fldPath.Child("selector", "issuerRefs").Index(i)
+		if ref.Name == "" {
+			el = append(el, field.Required(refPath.Child("name"), "must be specified"))
+		}
+		switch ref.Kind {
+		case "", v1alpha1.IssuerKind, v1alpha1.ClusterIssuerKind:
+		default:
+			el = append(el, field.Invalid(refPath.Child("kind"), ref.Kind, "must be one of Issuer or ClusterIssuer"))
+		}
+	}
+
+	for i, pattern := range spec.AllowedDNSNames {
+		if !validDNSNamePattern(pattern) {
+			el = append(el, field.Invalid(fldPath.Child("allowedDNSNames").Index(i), pattern, "must be a DNS name, where any label may be '*'"))
+		}
+	}
+
+	for i, cidr := range spec.AllowedIPRanges {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("allowedIPRanges").Index(i), cidr, err.Error()))
+		}
+	}
+
+	for i, key := range spec.AllowedPrivateKeys {
+		keyPath := fldPath.Child("allowedPrivateKeys").Index(i)
+		switch key.Algorithm {
+		case v1alpha1.RSAKeyAlgorithm, v1alpha1.ECDSAKeyAlgorithm:
+		case v1alpha1.Ed25519KeyAlgorithm:
+			if len(key.Sizes) > 0 {
+				el = append(el, field.Forbidden(keyPath.Child("sizes"), "must not be set for the 'ed25519' algorithm"))
+			}
+		default:
+			el = append(el, field.NotSupported(keyPath.Child("algorithm"), key.Algorithm, []string{
+				string(v1alpha1.RSAKeyAlgorithm), string(v1alpha1.ECDSAKeyAlgorithm), string(v1alpha1.Ed25519KeyAlgorithm),
+			}))
+		}
+		for j, size := range key.Sizes {
+			if size <= 0 {
+				el = append(el, field.Invalid(keyPath.Child("sizes").Index(j), size, "must be greater than zero"))
+			}
+		}
+	}
+
+	if spec.MaxDuration != nil && spec.MaxDuration.Duration < v1alpha1.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("maxDuration"), spec.MaxDuration.Duration, fmt.Sprintf("must be greater than %s", v1alpha1.MinimumCertificateDuration)))
+	}
+
+	return el
+}
+
+func validDNSNamePattern(pattern string) bool {
+	if pattern == "" {
+		return false
+	}
+	for _, label := range strings.Split(pattern, ".") {
+		if label == "" || (label != "*" && strings.Contains(label, "*")) {
+			return false
+		}
+	}
+	return true
+}
+
+// CertificatePolicyApplies returns true if the given CertificatePolicy
+// selects resources in the given namespace that reference the given issuer.
+func CertificatePolicyApplies(p *v1alpha1.CertificatePolicy, namespace string, issuerRef v1alpha1.ObjectReference) bool {
+	sel := p.Spec.Selector
+	if len(sel.Namespaces) > 0 && !util.Contains(sel.Namespaces, namespace) {
+		return false
+	}
+	if len(sel.IssuerRefs) == 0 {
+		return true
+	}
+	for _, ref := range sel.IssuerRefs {
+		if issuerRefKind(ref) == issuerRefKind(issuerRef) && ref.Name == issuerRef.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func issuerRefKind(ref v1alpha1.ObjectReference) string {
+	if ref.Kind == "" {
+		return v1alpha1.IssuerKind
+	}
+	return ref.Kind
+}
+
+// policyRequest holds the properties of a requested certificate that are
+// constrained by CertificatePolicies, along with the paths of the fields
+// they were read from.
+type policyRequest struct {
+	commonName   string
+	dnsNames     []string
+	ipAddresses  []net.IP
+	keyAlgorithm v1alpha1.KeyAlgorithm
+	keySize      int
+	duration     time.Duration
+	isCA         bool
+
+	commonNamePath, dnsNamesPath, ipAddressesPath, keyPath, durationPath, isCAPath *field.Path
+}
+
+// ValidateCertificateForPolicies checks that the given Certificate is
+// permitted by all of the given CertificatePolicies that select it.
+func ValidateCertificateForPolicies(crt *v1alpha1.Certificate, policies []*v1alpha1.CertificatePolicy) field.ErrorList {
+	crt = crt.DeepCopy()
+	v1alpha1.SetObjectDefaults_Certificate(crt)
+
+	duration := v1alpha1.DefaultCertificateDuration
+	if crt.Spec.Duration != nil {
+		duration = crt.Spec.Duration.Duration
+	}
+
+	specPath := field.NewPath("spec")
+	req := &policyRequest{
+		commonName:      crt.Spec.CommonName,
+		dnsNames:        crt.Spec.DNSNames,
+		ipAddresses:     pki.IPAddressesForCertificate(crt),
+		keyAlgorithm:    crt.Spec.KeyAlgorithm,
+		keySize:         crt.Spec.KeySize,
+		duration:        duration,
+		isCA:            crt.Spec.IsCA,
+		commonNamePath:  specPath.Child("commonName"),
+		dnsNamesPath:    specPath.Child("dnsNames"),
+		ipAddressesPath: specPath.Child("ipAddresses"),
+		keyPath:         specPath.Child("keyAlgorithm"),
+		durationPath:    specPath.Child("duration"),
+		isCAPath:        specPath.Child("isCA"),
+	}
+
+	return validateRequestForPolicies(req, crt.Namespace, crt.Spec.IssuerRef, policies)
+}
+
+// ValidateCertificateRequestForPolicies checks that the given
+// CertificateRequest is permitted by all of the given CertificatePolicies
+// that select it.
+func ValidateCertificateRequestForPolicies(cr *v1alpha1.CertificateRequest, policies []*v1alpha1.CertificatePolicy) field.ErrorList {
+	cr = cr.DeepCopy()
+	v1alpha1.SetObjectDefaults_CertificateRequest(cr)
+
+	specPath := field.NewPath("spec")
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath.Child("csr"), cr.Spec.CSRPEM, fmt.Sprintf("failed to decode csr: %s", err))}
+	}
+
+	req := &policyRequest{
+		commonName:      csr.Subject.CommonName,
+		dnsNames:        csr.DNSNames,
+		ipAddresses:     csr.IPAddresses,
+		duration:        cr.Spec.Duration.Duration,
+		isCA:            cr.Spec.IsCA,
+		commonNamePath:  specPath.Child("csr"),
+		dnsNamesPath:    specPath.Child("csr"),
+		ipAddressesPath: specPath.Child("csr"),
+		keyPath:         specPath.Child("csr"),
+		durationPath:    specPath.Child("duration"),
+		isCAPath:        specPath.Child("isCA"),
+	}
+	switch pub := csr.PublicKey.(type) {
+	case *rsa.PublicKey:
+		req.keyAlgorithm, req.keySize = v1alpha1.RSAKeyAlgorithm, pub.N.BitLen()
+	case *ecdsa.PublicKey:
+		req.keyAlgorithm, req.keySize = v1alpha1.ECDSAKeyAlgorithm, pub.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		req.keyAlgorithm = v1alpha1.Ed25519KeyAlgorithm
+	}
+
+	return validateRequestForPolicies(req, cr.Namespace, cr.Spec.IssuerRef, policies)
+}
+
+func validateRequestForPolicies(req *policyRequest, namespace string, issuerRef v1alpha1.ObjectReference, policies []*v1alpha1.CertificatePolicy) field.ErrorList {
+	el := field.ErrorList{}
+	for _, p := range policies {
+		if CertificatePolicyApplies(p, namespace, issuerRef) {
+			el = append(el, validateRequestForPolicy(req, p)...)
+		}
+	}
+	return el
+}
+
+func validateRequestForPolicy(req *policyRequest, p *v1alpha1.CertificatePolicy) field.ErrorList {
+	el := field.ErrorList{}
+	spec := &p.Spec
+	forbidden := func(fldPath *field.Path, format string, args ...interface{}) {
+		el = append(el, field.Forbidden(fldPath, fmt.Sprintf(format, args...)+fmt.Sprintf(" is not permitted by CertificatePolicy %q", p.Name)))
+	}
+
+	if len(spec.AllowedDNSNames) > 0 {
+		if req.commonName != "" && !dnsNameAllowed(req.commonName, spec.AllowedDNSNames) {
+			forbidden(req.commonNamePath, "common name %q", req.commonName)
+		}
+		for _, name := range req.dnsNames {
+			if !dnsNameAllowed(name, spec.AllowedDNSNames) {
+				forbidden(req.dnsNamesPath, "DNS name %q", name)
+			}
+		}
+	}
+
+	if len(spec.AllowedIPRanges) > 0 {
+		for _, ip := range req.ipAddresses {
+			if !ipAddressAllowed(ip, spec.AllowedIPRanges) {
+				forbidden(req.ipAddressesPath, "IP address %q", ip.String())
+			}
+		}
+	}
+
+	if len(spec.AllowedPrivateKeys) > 0 && !privateKeyAllowed(req.keyAlgorithm, req.keySize, spec.AllowedPrivateKeys) {
+		if req.keyAlgorithm == v1alpha1.Ed25519KeyAlgorithm {
+			forbidden(req.keyPath, "private key algorithm %q", req.keyAlgorithm)
+		} else {
+			forbidden(req.keyPath, "private key algorithm %q with size %d", req.keyAlgorithm, req.keySize)
+		}
+	}
+
+	if spec.MaxDuration != nil && req.duration > spec.MaxDuration.Duration {
+		forbidden(req.durationPath, "duration %s", req.duration)
+	}
+
+	if req.isCA && !spec.AllowCA {
+		forbidden(req.isCAPath, "CA certificate")
+	}
+
+	return el
+}
+
+// dnsNameAllowed returns true if the given DNS name matches any of the given
+// patterns, where a '*' label in a pattern matches any single label.
+func dnsNameAllowed(name string, patterns []string) bool {
+	nameLabels := strings.Split(strings.ToLower(name), ".")
+Patterns:
+	for _, pattern := range patterns {
+		patternLabels := strings.Split(strings.ToLower(pattern), ".")
+		if len(patternLabels) != len(nameLabels) {
+			continue
+		}
+		for i, l := range patternLabels {
+			if l != "*" && l != nameLabels[i] {
+				continue Patterns
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func ipAddressAllowed(ip net.IP, cidrs []string) bool {
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func privateKeyAllowed(alg v1alpha1.KeyAlgorithm, size int, allowed []v1alpha1.CertificatePolicyPrivateKey) bool {
+	for _, key := range allowed {
+		if key.Algorithm != alg {
+			continue
+		}
+		if len(key.Sizes) == 0 {
+			return true
+		}
+		for _, s := range key.Sizes {
+			if s == size {
+				return true
+			}
+		}
+	}
+	return false
+}
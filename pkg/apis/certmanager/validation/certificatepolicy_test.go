@@ -0,0 +1,326 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestValidateCertificatePolicy(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	scenarios := map[string]struct {
+		spec v1alpha1.CertificatePolicySpec
+		errs []*field.Error
+	}{
+		"valid policy": {
+			spec: v1alpha1.CertificatePolicySpec{
+				Selector: v1alpha1.CertificatePolicySelector{
+					IssuerRefs: []v1alpha1.ObjectReference{{Name: "ca", Kind: v1alpha1.ClusterIssuerKind}},
+					Namespaces: []string{"dev"},
+				},
+				AllowedDNSNames:    []string{"*.dev.example.com", "example.com"},
+				AllowedIPRanges:    []string{"10.0.0.0/8", "fd00::/8"},
+				AllowedPrivateKeys: []v1alpha1.CertificatePolicyPrivateKey{{Algorithm: v1alpha1.RSAKeyAlgorithm, Sizes: []int{2048, 4096}}, {Algorithm: v1alpha1.Ed25519KeyAlgorithm}},
+				MaxDuration:        &metav1.Duration{Duration: time.Hour * 24},
+			},
+		},
+		"invalid issuer reference": {
+			spec: v1alpha1.CertificatePolicySpec{
+				Selector: v1alpha1.CertificatePolicySelector{
+					IssuerRefs: []v1alpha1.ObjectReference{{Kind: "Other"}},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("selector", "issuerRefs").Index(0).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Child("selector", "issuerRefs").Index(0).Child("kind"), "Other", "must be one of Issuer or ClusterIssuer"),
+			},
+		},
+		"invalid dns name pattern": {
+			spec: v1alpha1.CertificatePolicySpec{
+				AllowedDNSNames: []string{"a*.example.com", "example..com"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("allowedDNSNames").Index(0), "a*.example.com", "must be a DNS name, where any label may be '*'"),
+				field.Invalid(fldPath.Child("allowedDNSNames").Index(1), "example..com", "must be a DNS name, where any label may be '*'"),
+			},
+		},
+		"invalid ip range": {
+			spec: v1alpha1.CertificatePolicySpec{
+				AllowedIPRanges: []string{"10.0.0.1"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("allowedIPRanges").Index(0), "10.0.0.1", "invalid CIDR address: 10.0.0.1"),
+			},
+		},
+		"invalid private keys": {
+			spec: v1alpha1.CertificatePolicySpec{
+				AllowedPrivateKeys: []v1alpha1.CertificatePolicyPrivateKey{
+					{Algorithm: "dsa"},
+					{Algorithm: v1alpha1.Ed25519KeyAlgorithm, Sizes: []int{256}},
+					{Algorithm: v1alpha1.ECDSAKeyAlgorithm, Sizes: []int{0}},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("allowedPrivateKeys").Index(0).Child("algorithm"), v1alpha1.KeyAlgorithm("dsa"), []string{"rsa", "ecdsa", "ed25519"}),
+				field.Forbidden(fldPath.Child("allowedPrivateKeys").Index(1).Child("sizes"), "must not be set for the 'ed25519' algorithm"),
+				field.Invalid(fldPath.Child("allowedPrivateKeys").Index(2).Child("sizes").Index(0), 0, "must be greater than zero"),
+			},
+		},
+		"max duration too short": {
+			spec: v1alpha1.CertificatePolicySpec{
+				MaxDuration: &metav1.Duration{Duration: time.Minute},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxDuration"), time.Minute, "must be greater than 1h0m0s"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCertificatePolicy(&v1alpha1.CertificatePolicy{Spec: s.spec})
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestCertificatePolicyApplies(t *testing.T) {
+	policy := func(sel v1alpha1.CertificatePolicySelector) *v1alpha1.CertificatePolicy {
+		return &v1alpha1.CertificatePolicy{Spec: v1alpha1.CertificatePolicySpec{Selector: sel}}
+	}
+	scenarios := map[string]struct {
+		policy    *v1alpha1.CertificatePolicy
+		namespace string
+		issuerRef v1alpha1.ObjectReference
+		applies   bool
+	}{
+		"empty selector applies to everything": {
+			policy:    policy(v1alpha1.CertificatePolicySelector{}),
+			namespace: "dev",
+			issuerRef: v1alpha1.ObjectReference{Name: "ca"},
+			applies:   true,
+		},
+		"matching namespace and issuer": {
+			policy: policy(v1alpha1.CertificatePolicySelector{
+				Namespaces: []string{"dev"},
+				IssuerRefs: []v1alpha1.ObjectReference{{Name: "ca"}},
+			}),
+			namespace: "dev",
+			issuerRef: v1alpha1.ObjectReference{Name: "ca", Kind: v1alpha1.IssuerKind},
+			applies:   true,
+		},
+		"different namespace": {
+			policy:    policy(v1alpha1.CertificatePolicySelector{Namespaces: []string{"dev"}}),
+			namespace: "prod",
+			issuerRef: v1alpha1.ObjectReference{Name: "ca"},
+			applies:   false,
+		},
+		"different issuer kind": {
+			policy:    policy(v1alpha1.CertificatePolicySelector{IssuerRefs: []v1alpha1.ObjectReference{{Name: "ca", Kind: v1alpha1.ClusterIssuerKind}}}),
+			namespace: "dev",
+			issuerRef: v1alpha1.ObjectReference{Name: "ca"},
+			applies:   false,
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			if applies := CertificatePolicyApplies(s.policy, s.namespace, s.issuerRef); applies != s.applies {
+				t.Errorf("Expected %t but got %t", s.applies, applies)
+			}
+		})
+	}
+}
+
+func TestValidateCertificateForPolicies(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	policy := &v1alpha1.CertificatePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+		Spec: v1alpha1.CertificatePolicySpec{
+			Selector: v1alpha1.CertificatePolicySelector{
+				Namespaces: []string{"dev"},
+				IssuerRefs: []v1alpha1.ObjectReference{{Name: "shared", Kind: v1alpha1.ClusterIssuerKind}},
+			},
+			AllowedDNSNames:    []string{"*.dev.corp"},
+			AllowedIPRanges:    []string{"10.0.0.0/8"},
+			AllowedPrivateKeys: []v1alpha1.CertificatePolicyPrivateKey{{Algorithm: v1alpha1.RSAKeyAlgorithm, Sizes: []int{2048, 4096}}},
+			MaxDuration:        &metav1.Duration{Duration: time.Hour * 24 * 30},
+		},
+	}
+	baseCrt := &v1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "dev"},
+		Spec: v1alpha1.CertificateSpec{
+			SecretName:  "test",
+			DNSNames:    []string{"www.dev.corp"},
+			IPAddresses: []string{"10.0.0.1"},
+			Duration:    &metav1.Duration{Duration: time.Hour * 24},
+			IssuerRef:   v1alpha1.ObjectReference{Name: "shared", Kind: v1alpha1.ClusterIssuerKind},
+		},
+	}
+
+	scenarios := map[string]struct {
+		update func(crt *v1alpha1.Certificate)
+		errs   []*field.Error
+	}{
+		"permitted certificate": {
+			update: func(crt *v1alpha1.Certificate) {},
+		},
+		"policy does not apply to other namespaces": {
+			update: func(crt *v1alpha1.Certificate) {
+				crt.Namespace = "prod"
+				crt.Spec.DNSNames = []string{"www.payments.corp"}
+			},
+		},
+		"dns name not permitted": {
+			update: func(crt *v1alpha1.Certificate) {
+				crt.Spec.CommonName = "dev.corp"
+				crt.Spec.DNSNames = []string{"www.payments.corp", "a.b.dev.corp"}
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("commonName"), `common name "dev.corp" is not permitted by CertificatePolicy "dev"`),
+				field.Forbidden(fldPath.Child("dnsNames"), `DNS name "www.payments.corp" is not permitted by CertificatePolicy "dev"`),
+				field.Forbidden(fldPath.Child("dnsNames"), `DNS name "a.b.dev.corp" is not permitted by CertificatePolicy "dev"`),
+			},
+		},
+		"ip address not permitted": {
+			update: func(crt *v1alpha1.Certificate) {
+				crt.Spec.IPAddresses = []string{"192.168.0.1"}
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("ipAddresses"), `IP address "192.168.0.1" is not permitted by CertificatePolicy "dev"`),
+			},
+		},
+		"defaulted private key is checked": {
+			update: func(crt *v1alpha1.Certificate) {
+				crt.Spec.KeyAlgorithm = v1alpha1.ECDSAKeyAlgorithm
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("keyAlgorithm"), `private key algorithm "ecdsa" with size 256 is not permitted by CertificatePolicy "dev"`),
+			},
+		},
+		"defaulted duration is checked": {
+			update: func(crt *v1alpha1.Certificate) {
+				crt.Spec.Duration = nil
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("duration"), `duration 2160h0m0s is not permitted by CertificatePolicy "dev"`),
+			},
+		},
+		"ca not permitted": {
+			update: func(crt *v1alpha1.Certificate) {
+				crt.Spec.IsCA = true
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("isCA"), `CA certificate is not permitted by CertificatePolicy "dev"`),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			crt := baseCrt.DeepCopy()
+			s.update(crt)
+			errs := ValidateCertificateForPolicies(crt, []*v1alpha1.CertificatePolicy{policy})
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCertificateRequestForPolicies(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	cr := &v1alpha1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "dev"},
+		Spec: v1alpha1.CertificateRequestSpec{
+			// the CSR requests 'example.com' using a P-256 ECDSA key
+			CSRPEM:    generateCSR(t, nil, false),
+			IssuerRef: v1alpha1.ObjectReference{Name: "ca"},
+			IsCA:      true,
+		},
+	}
+
+	scenarios := map[string]struct {
+		spec v1alpha1.CertificatePolicySpec
+		errs []*field.Error
+	}{
+		"permitted certificate request": {
+			spec: v1alpha1.CertificatePolicySpec{
+				AllowedDNSNames:    []string{"example.com"},
+				AllowedPrivateKeys: []v1alpha1.CertificatePolicyPrivateKey{{Algorithm: v1alpha1.ECDSAKeyAlgorithm, Sizes: []int{256}}},
+				AllowCA:            true,
+			},
+		},
+		"names and key read from the csr are not permitted": {
+			spec: v1alpha1.CertificatePolicySpec{
+				AllowedDNSNames:    []string{"*.example.com"},
+				AllowedPrivateKeys: []v1alpha1.CertificatePolicyPrivateKey{{Algorithm: v1alpha1.ECDSAKeyAlgorithm, Sizes: []int{384}}},
+				AllowCA:            true,
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("csr"), `common name "example.com" is not permitted by CertificatePolicy "policy"`),
+				field.Forbidden(fldPath.Child("csr"), `DNS name "example.com" is not permitted by CertificatePolicy "policy"`),
+				field.Forbidden(fldPath.Child("csr"), `private key algorithm "ecdsa" with size 256 is not permitted by CertificatePolicy "policy"`),
+			},
+		},
+		"defaulted duration and isCA are not permitted": {
+			spec: v1alpha1.CertificatePolicySpec{
+				MaxDuration: &metav1.Duration{Duration: time.Hour * 24},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("duration"), `duration 2160h0m0s is not permitted by CertificatePolicy "policy"`),
+				field.Forbidden(fldPath.Child("isCA"), `CA certificate is not permitted by CertificatePolicy "policy"`),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			policy := &v1alpha1.CertificatePolicy{ObjectMeta: metav1.ObjectMeta{Name: "policy"}, Spec: s.spec}
+			errs := ValidateCertificateRequestForPolicies(cr, []*v1alpha1.CertificatePolicy{policy})
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
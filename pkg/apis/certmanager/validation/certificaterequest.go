@@ -17,8 +17,10 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/x509"
 	"fmt"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -27,9 +29,88 @@ import (
 
 func ValidateCertificateRequest(cr *v1alpha1.CertificateRequest) field.ErrorList {
 	allErrs := ValidateCertificateRequestSpec(&cr.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateCertificateRequestApprovalConditions(cr.Status.Conditions, field.NewPath("status", "conditions"))...)
 	return allErrs
 }
 
+// ValidateCertificateRequestUpdate validates an update to a
+// CertificateRequest. The spec of a CertificateRequest is immutable once it
+// has been created.
+func ValidateCertificateRequestUpdate(oldCR, newCR *v1alpha1.CertificateRequest) field.ErrorList {
+	allErrs := ValidateCertificateRequest(newCR)
+
+	// the defaults may have been persisted on the resource after it was
+	// created, so they are applied to both specs before they are compared
+	oldCR = oldCR.DeepCopy()
+	newCR = newCR.DeepCopy()
+	v1alpha1.SetObjectDefaults_CertificateRequest(oldCR)
+	v1alpha1.SetObjectDefaults_CertificateRequest(newCR)
+	if !apiequality.Semantic.DeepEqual(oldCR.Spec, newCR.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable once the CertificateRequest has been created"))
+	}
+
+	allErrs = append(allErrs, validateCertificateRequestApprovalUpdate(oldCR.Status.Conditions, newCR.Status.Conditions, field.NewPath("status", "conditions"))...)
+
+	return allErrs
+}
+
+// validateCertificateRequestApprovalConditions checks that the 'Approved' and
+// 'Denied' conditions are only ever set to 'True', and that a
+// CertificateRequest is not both approved and denied.
+func validateCertificateRequestApprovalConditions(conds []v1alpha1.CertificateRequestCondition, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	var approved, denied bool
+	for i, c := range conds {
+		if c.Type != v1alpha1.CertificateRequestConditionApproved && c.Type != v1alpha1.CertificateRequestConditionDenied {
+			continue
+		}
+		if c.Status != v1alpha1.ConditionTrue {
+			el = append(el, field.NotSupported(fldPath.Index(i).Child("status"), c.Status, []string{string(v1alpha1.ConditionTrue)}))
+			continue
+		}
+		if c.Type == v1alpha1.CertificateRequestConditionApproved {
+			approved = true
+		} else {
+			denied = true
+		}
+	}
+
+	if approved && denied {
+		el = append(el, field.Forbidden(fldPath, "a CertificateRequest cannot be both approved and denied"))
+	}
+
+	return el
+}
+
+// validateCertificateRequestApprovalUpdate checks that the 'Approved' and
+// 'Denied' conditions are not changed or removed once they have been set.
+func validateCertificateRequestApprovalUpdate(oldConds, newConds []v1alpha1.CertificateRequestCondition, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for _, t := range []v1alpha1.CertificateRequestConditionType{v1alpha1.CertificateRequestConditionApproved, v1alpha1.CertificateRequestConditionDenied} {
+		oldCond := getCertificateRequestCondition(oldConds, t)
+		if oldCond == nil {
+			continue
+		}
+		newCond := getCertificateRequestCondition(newConds, t)
+		if newCond == nil || newCond.Status != oldCond.Status || newCond.Reason != oldCond.Reason || newCond.Message != oldCond.Message {
+			el = append(el, field.Forbidden(fldPath, fmt.Sprintf("the %q condition is immutable once it has been set", t)))
+		}
+	}
+
+	return el
+}
+
+func getCertificateRequestCondition(conds []v1alpha1.CertificateRequestCondition, t v1alpha1.CertificateRequestConditionType) *v1alpha1.CertificateRequestCondition {
+	for i := range conds {
+		if conds[i].Type == t {
+			return &conds[i]
+		}
+	}
+	return nil
+}
+
 func ValidateCertificateRequestSpec(crSpec *v1alpha1.CertificateRequestSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -38,11 +119,48 @@ func ValidateCertificateRequestSpec(crSpec *v1alpha1.CertificateRequestSpec, fld
 	if len(crSpec.CSRPEM) == 0 {
 		el = append(el, field.Required(fldPath.Child("csr"), "must be specified"))
 	} else {
-		_, err := pki.DecodeX509CertificateRequestBytes(crSpec.CSRPEM)
+		csr, err := pki.DecodeX509CertificateRequestBytes(crSpec.CSRPEM)
 		if err != nil {
 			el = append(el, field.Invalid(fldPath.Child("csr"), crSpec.CSRPEM, fmt.Sprintf("failed to decode csr: %s", err)))
+		} else {
+			el = append(el, validateCSR(crSpec, csr, fldPath)...)
 		}
 	}
 
+	if crSpec.Duration != nil && crSpec.Duration.Duration < v1alpha1.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), crSpec.Duration.Duration, fmt.Sprintf("certificate duration must be greater than %s", v1alpha1.MinimumCertificateDuration)))
+	}
+
+	if len(crSpec.Usages) > 0 {
+		el = append(el, validateUsages(crSpec.Usages, fldPath)...)
+	}
+
+	return el
+}
+
+// validateCSR checks the signature of the CSR, and that the CA certificate
+// and usages it requests are consistent with the isCA field.
+func validateCSR(crSpec *v1alpha1.CertificateRequestSpec, csr *x509.CertificateRequest, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if err := csr.CheckSignature(); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("csr"), crSpec.CSRPEM, fmt.Sprintf("invalid csr signature: %s", err)))
+		return el
+	}
+
+	isCA, ok, err := pki.IsCAForCSR(csr)
+	if err != nil {
+		el = append(el, field.Invalid(fldPath.Child("csr"), crSpec.CSRPEM, err.Error()))
+	} else if ok && isCA != crSpec.IsCA {
+		el = append(el, field.Invalid(fldPath.Child("isCA"), crSpec.IsCA, fmt.Sprintf("does not match the basic constraints requested in the csr (isCA: %t)", isCA)))
+	}
+
+	ku, _, _, err := pki.KeyUsagesForCSR(csr)
+	if err != nil {
+		el = append(el, field.Invalid(fldPath.Child("csr"), crSpec.CSRPEM, err.Error()))
+	} else if !crSpec.IsCA && ku&x509.KeyUsageCertSign != 0 {
+		el = append(el, field.Invalid(fldPath.Child("isCA"), crSpec.IsCA, "must be true if the csr requests the 'cert sign' usage"))
+	}
+
 	return el
 }
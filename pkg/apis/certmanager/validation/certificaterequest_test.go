@@ -0,0 +1,316 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+func generateCSR(t *testing.T, extensions []pkix.Extension, corruptSignature bool) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         pkix.Name{CommonName: "example.com"},
+		DNSNames:        []string{"example.com"},
+		ExtraExtensions: extensions,
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if corruptSignature {
+		// the signature is at the end of the DER encoded CSR
+		der[len(der)-1] ^= 0xff
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func basicConstraintsExtension(t *testing.T, isCA bool) pkix.Extension {
+	value, err := asn1.Marshal(struct {
+		IsCA bool `asn1:"optional"`
+	}{IsCA: isCA})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkix.Extension{Id: pki.OIDExtensionBasicConstraints, Critical: true, Value: value}
+}
+
+func keyUsageExtension(t *testing.T, ku x509.KeyUsage) pkix.Extension {
+	exts, err := pki.BuildKeyUsageExtensions(ku, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exts[0]
+}
+
+func TestValidateCertificateRequest(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	validCSR := generateCSR(t, nil, false)
+	caCSR := generateCSR(t, []pkix.Extension{basicConstraintsExtension(t, true)}, false)
+	certSignCSR := generateCSR(t, []pkix.Extension{keyUsageExtension(t, x509.KeyUsageCertSign)}, false)
+	invalidSignatureCSR := generateCSR(t, nil, true)
+
+	scenarios := map[string]struct {
+		cr   *v1alpha1.CertificateRequest
+		errs []*field.Error
+	}{
+		"valid certificate request": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    validCSR,
+					IssuerRef: validIssuerRef,
+				},
+			},
+		},
+		"valid CA certificate request": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    caCSR,
+					IssuerRef: validIssuerRef,
+					IsCA:      true,
+				},
+			},
+		},
+		"valid certificate request requesting cert sign usage": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    certSignCSR,
+					IssuerRef: validIssuerRef,
+					IsCA:      true,
+				},
+			},
+		},
+		"missing csr": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("csr"), "must be specified"),
+			},
+		},
+		"csr with an invalid signature": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    invalidSignatureCSR,
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("csr"), invalidSignatureCSR, "invalid csr signature: x509: ECDSA verification failure"),
+			},
+		},
+		"csr requesting a CA certificate without isCA set": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    caCSR,
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("isCA"), false, "does not match the basic constraints requested in the csr (isCA: true)"),
+			},
+		},
+		"csr requesting cert sign usage without isCA set": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    certSignCSR,
+					IssuerRef: validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("isCA"), false, "must be true if the csr requests the 'cert sign' usage"),
+			},
+		},
+		"duration less than the minimum certificate duration": {
+			cr: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    validCSR,
+					IssuerRef: validIssuerRef,
+					Duration:  &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("duration"), time.Minute, "certificate duration must be greater than 1h0m0s"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCertificateRequest(s.cr)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCertificateRequestUpdate(t *testing.T) {
+	csr := generateCSR(t, nil, false)
+	baseCR := &v1alpha1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: v1alpha1.CertificateRequestSpec{
+			CSRPEM:    csr,
+			IssuerRef: v1alpha1.ObjectReference{Name: "test"},
+		},
+	}
+
+	approvedCondition := v1alpha1.CertificateRequestCondition{
+		Type:   v1alpha1.CertificateRequestConditionApproved,
+		Status: v1alpha1.ConditionTrue,
+		Reason: "Approved",
+	}
+	deniedCondition := v1alpha1.CertificateRequestCondition{
+		Type:   v1alpha1.CertificateRequestConditionDenied,
+		Status: v1alpha1.ConditionTrue,
+		Reason: "Denied",
+	}
+
+	scenarios := map[string]struct {
+		old    func(cr *v1alpha1.CertificateRequest)
+		update func(cr *v1alpha1.CertificateRequest)
+		errs   []*field.Error
+	}{
+		"metadata may be changed": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Labels = map[string]string{"test": "label"}
+			},
+		},
+		"defaults may be set": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				v1alpha1.SetObjectDefaults_CertificateRequest(cr)
+			},
+		},
+		"issuerRef may not be changed": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Spec.IssuerRef.Name = "other"
+			},
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("spec"), "field is immutable once the CertificateRequest has been created"),
+			},
+		},
+		"csr may not be changed": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Spec.CSRPEM = generateCSR(t, nil, false)
+			},
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("spec"), "field is immutable once the CertificateRequest has been created"),
+			},
+		},
+		"approved condition may be added": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = []v1alpha1.CertificateRequestCondition{approvedCondition}
+			},
+		},
+		"approved condition must be true": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = []v1alpha1.CertificateRequestCondition{
+					{Type: v1alpha1.CertificateRequestConditionApproved, Status: v1alpha1.ConditionFalse},
+				}
+			},
+			errs: []*field.Error{
+				field.NotSupported(field.NewPath("status", "conditions").Index(0).Child("status"), v1alpha1.ConditionFalse, []string{string(v1alpha1.ConditionTrue)}),
+			},
+		},
+		"cannot be both approved and denied": {
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = []v1alpha1.CertificateRequestCondition{approvedCondition, deniedCondition}
+			},
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("status", "conditions"), "a CertificateRequest cannot be both approved and denied"),
+			},
+		},
+		"approved condition may not be removed": {
+			old: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = []v1alpha1.CertificateRequestCondition{approvedCondition}
+			},
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = nil
+			},
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("status", "conditions"), `the "Approved" condition is immutable once it has been set`),
+			},
+		},
+		"denied condition may not be changed": {
+			old: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = []v1alpha1.CertificateRequestCondition{deniedCondition}
+			},
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions[0].Reason = "Other"
+			},
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("status", "conditions"), `the "Denied" condition is immutable once it has been set`),
+			},
+		},
+		"other conditions may be changed once approved": {
+			old: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = []v1alpha1.CertificateRequestCondition{approvedCondition}
+			},
+			update: func(cr *v1alpha1.CertificateRequest) {
+				cr.Status.Conditions = append(cr.Status.Conditions, v1alpha1.CertificateRequestCondition{
+					Type:   v1alpha1.CertificateRequestConditionReady,
+					Status: v1alpha1.ConditionTrue,
+				})
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			oldCR := baseCR.DeepCopy()
+			if s.old != nil {
+				s.old(oldCR)
+			}
+			newCR := oldCR.DeepCopy()
+			s.update(newCR)
+			errs := ValidateCertificateRequestUpdate(oldCR, newCR)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package validation
 
 import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -26,5 +28,24 @@ import (
 
 func ValidateClusterIssuer(iss *v1alpha1.ClusterIssuer) field.ErrorList {
 	allErrs := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	if iss.Spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, ValidateIssuerNamespaceSelector(iss.Spec.NamespaceSelector, field.NewPath("spec", "namespaceSelector"))...)
+	}
 	return allErrs
 }
+
+func ValidateIssuerNamespaceSelector(sel *v1alpha1.IssuerNamespaceSelector, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(sel.MatchNames) == 0 && sel.Selector == nil {
+		el = append(el, field.Required(fldPath, "at least one of matchNames or selector must be specified"))
+	}
+	for i, name := range sel.MatchNames {
+		for _, msg := range validation.IsDNS1123Label(name) {
+			el = append(el, field.Invalid(fldPath.Child("matchNames").Index(i), name, msg))
+		}
+	}
+	if sel.Selector != nil {
+		el = append(el, metav1validation.ValidateLabelSelector(sel.Selector, fldPath.Child("selector"))...)
+	}
+	return el
+}
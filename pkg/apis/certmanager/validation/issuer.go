@@ -26,6 +26,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -35,6 +36,9 @@ import (
 
 func ValidateIssuer(iss *v1alpha1.Issuer) field.ErrorList {
 	allErrs := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	if iss.Spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "namespaceSelector"), "may only be set on ClusterIssuers"))
+	}
 	return allErrs
 }
 
@@ -102,6 +106,27 @@ func ValidateACMEIssuerConfig(iss *v1alpha1.ACMEIssuer, fldPath *field.Path) fie
 	if len(iss.Server) == 0 {
 		el = append(el, field.Required(fldPath.Child("server"), "acme server URL is a required field"))
 	}
+	if len(iss.PreferredChain) > 64 {
+		el = append(el, field.TooLong(fldPath.Child("preferredChain"), iss.PreferredChain, 64))
+	}
+	if iss.PrivateKeySize < 0 {
+		el = append(el, field.Invalid(fldPath.Child("privateKeySize"), iss.PrivateKeySize, "cannot be less than zero"))
+	}
+	switch iss.PrivateKeyAlgorithm {
+	case v1alpha1.KeyAlgorithm(""), v1alpha1.RSAKeyAlgorithm:
+		if iss.PrivateKeySize > 0 && (iss.PrivateKeySize < 2048 || iss.PrivateKeySize > 8192) {
+			el = append(el, field.Invalid(fldPath.Child("privateKeySize"), iss.PrivateKeySize, "must be between 2048 & 8192 for rsa privateKeyAlgorithm"))
+		}
+	case v1alpha1.ECDSAKeyAlgorithm:
+		if iss.PrivateKeySize > 0 && iss.PrivateKeySize != 256 && iss.PrivateKeySize != 384 {
+			el = append(el, field.NotSupported(fldPath.Child("privateKeySize"), iss.PrivateKeySize, []string{"256", "384"}))
+		}
+	default:
+		el = append(el, field.Invalid(fldPath.Child("privateKeyAlgorithm"), iss.PrivateKeyAlgorithm, "must be either empty or one of rsa or ecdsa"))
+	}
+	if iss.ExternalAccountBinding != nil {
+		el = append(el, ValidateACMEExternalAccountBinding(iss.ExternalAccountBinding, fldPath.Child("externalAccountBinding"))...)
+	}
 	if iss.HTTP01 != nil {
 		el = append(el, ValidateACMEIssuerHTTP01Config(iss.HTTP01, fldPath.Child("http01"))...)
 	}
@@ -115,12 +140,56 @@ func ValidateACMEIssuerConfig(iss *v1alpha1.ACMEIssuer, fldPath *field.Path) fie
 	return el
 }
 
+func ValidateACMEExternalAccountBinding(eab *v1alpha1.ACMEExternalAccountBinding, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(eab.KeyID) == 0 {
+		el = append(el, field.Required(fldPath.Child("keyID"), "key ID is a required field"))
+	}
+	if len(eab.Key.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("keySecretRef", "name"), "key secret name is a required field"))
+	}
+	if len(eab.Key.Key) == 0 {
+		el = append(el, field.Required(fldPath.Child("keySecretRef", "key"), "key secret key is a required field"))
+	}
+	switch eab.KeyAlgorithm {
+	case "", v1alpha1.HS256, v1alpha1.HS384, v1alpha1.HS512:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("keyAlgorithm"), eab.KeyAlgorithm,
+			[]string{string(v1alpha1.HS256), string(v1alpha1.HS384), string(v1alpha1.HS512)}))
+	}
+
+	return el
+}
+
 func ValidateACMEIssuerChallengeSolverConfig(sol *v1alpha1.ACMEChallengeSolver, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
 	if sol.HTTP01 != nil {
 		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01Config(sol.HTTP01, fldPath.Child("http01"))...)
 	}
+	if sol.TLSALPN01 != nil {
+		el = append(el, ValidateACMEIssuerChallengeSolverTLSALPN01Config(sol.TLSALPN01, fldPath.Child("tlsalpn01"))...)
+	}
+
+	return el
+}
+
+func ValidateACMEIssuerChallengeSolverTLSALPN01Config(tlsalpn01 *v1alpha1.ACMEChallengeSolverTLSALPN01, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if tlsalpn01.NodePort != 0 {
+		if tlsalpn01.ServiceType != "" && tlsalpn01.ServiceType != corev1.ServiceTypeNodePort && tlsalpn01.ServiceType != corev1.ServiceTypeLoadBalancer {
+			el = append(el, field.Invalid(fldPath.Child("nodePort"), tlsalpn01.NodePort, fmt.Sprintf("may not be set for service type %q", tlsalpn01.ServiceType)))
+		}
+		for _, msg := range validation.IsValidPortNum(int(tlsalpn01.NodePort)) {
+			el = append(el, field.Invalid(fldPath.Child("nodePort"), tlsalpn01.NodePort, msg))
+		}
+	}
+
+	if tlsalpn01.PodTemplate != nil {
+		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01IngressPodTemplateConfig(tlsalpn01.PodTemplate, fldPath.Child("podTemplate"))...)
+	}
 
 	return el
 }
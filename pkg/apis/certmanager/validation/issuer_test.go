@@ -18,10 +18,12 @@ package validation
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -117,6 +119,88 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("server"), "acme server URL is a required field"),
 			},
 		},
+		"acme issuer with a preferred chain": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				PreferredChain: "ISRG Root X1",
+			},
+		},
+		"acme issuer with a preferred chain that is too long": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				PreferredChain: strings.Repeat("a", 65),
+			},
+			errs: []*field.Error{
+				field.TooLong(fldPath.Child("preferredChain"), strings.Repeat("a", 65), 64),
+			},
+		},
+		"acme issuer with an ecdsa private key": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:              "valid-server",
+				PrivateKey:          validSecretKeyRef,
+				PrivateKeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm,
+				PrivateKeySize:      384,
+			},
+		},
+		"acme issuer with an unsupported ecdsa private key size": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:              "valid-server",
+				PrivateKey:          validSecretKeyRef,
+				PrivateKeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm,
+				PrivateKeySize:      521,
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKeySize"), 521, []string{"256", "384"}),
+			},
+		},
+		"acme issuer with a weak rsa private key size": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				PrivateKeySize: 1024,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKeySize"), 1024, "must be between 2048 & 8192 for rsa privateKeyAlgorithm"),
+			},
+		},
+		"acme issuer with an unsupported private key algorithm": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:              "valid-server",
+				PrivateKey:          validSecretKeyRef,
+				PrivateKeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKeyAlgorithm"), v1alpha1.Ed25519KeyAlgorithm, "must be either empty or one of rsa or ecdsa"),
+			},
+		},
+		"acme issuer with valid external account binding": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				ExternalAccountBinding: &v1alpha1.ACMEExternalAccountBinding{
+					KeyID:        "kid-1",
+					Key:          validSecretKeyRef,
+					KeyAlgorithm: v1alpha1.HS384,
+				},
+			},
+		},
+		"acme issuer with invalid external account binding": {
+			spec: &v1alpha1.ACMEIssuer{
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				ExternalAccountBinding: &v1alpha1.ACMEExternalAccountBinding{
+					KeyAlgorithm: "RS256",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("externalAccountBinding", "keyID"), "key ID is a required field"),
+				field.Required(fldPath.Child("externalAccountBinding", "keySecretRef", "name"), "key secret name is a required field"),
+				field.Required(fldPath.Child("externalAccountBinding", "keySecretRef", "key"), "key secret key is a required field"),
+				field.NotSupported(fldPath.Child("externalAccountBinding", "keyAlgorithm"), v1alpha1.HMACKeyAlgorithm("RS256"), []string{"HS256", "HS384", "HS512"}),
+			},
+		},
 		"acme issuer with invalid dns01 config": {
 			spec: &v1alpha1.ACMEIssuer{
 				Email:      "valid-email",
@@ -256,6 +340,77 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 					"", "only labels and annotations may be set on podTemplate metadata"),
 			},
 		},
+		"acme issuer with invalid tlsalpn01 pod template ObjectMeta attributes": {
+			spec: &v1alpha1.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []v1alpha1.ACMEChallengeSolver{
+					{
+						TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+							PodTemplate: &v1alpha1.ACMEChallengeSolverHTTP01IngressPodTemplate{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "unable-to-change-name",
+								},
+							},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("solver", "tlsalpn01", "podTemplate", "metadata"),
+					"", "only labels and annotations may be set on podTemplate metadata"),
+			},
+		},
+		"acme issuer with a tlsalpn01 node port": {
+			spec: &v1alpha1.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []v1alpha1.ACMEChallengeSolver{
+					{
+						TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+							NodePort: 30443,
+						},
+					},
+				},
+			},
+		},
+		"acme issuer with a tlsalpn01 node port for a ClusterIP service": {
+			spec: &v1alpha1.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []v1alpha1.ACMEChallengeSolver{
+					{
+						TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+							ServiceType: corev1.ServiceTypeClusterIP,
+							NodePort:    30443,
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("solver", "tlsalpn01", "nodePort"), int32(30443), `may not be set for service type "ClusterIP"`),
+			},
+		},
+		"acme issuer with an invalid tlsalpn01 node port": {
+			spec: &v1alpha1.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []v1alpha1.ACMEChallengeSolver{
+					{
+						TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+							NodePort: 70000,
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("solver", "tlsalpn01", "nodePort"), int32(70000), validation.InclusiveRangeError(1, 65535)),
+			},
+		},
 		"acme issue with valid pod template PodSpec attributes": {
 			spec: &v1alpha1.ACMEIssuer{
 				Email:      "valid-email",
@@ -830,3 +985,81 @@ func TestValidateSecretKeySelector(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIssuerNamespaceSelector(t *testing.T) {
+	fldPath := field.NewPath("spec", "namespaceSelector")
+	scenarios := map[string]struct {
+		iss  v1alpha1.GenericIssuer
+		errs []*field.Error
+	}{
+		"clusterissuer with namespace names and a label selector": {
+			iss: &v1alpha1.ClusterIssuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{SelfSigned: &v1alpha1.SelfSignedIssuer{}},
+					NamespaceSelector: &v1alpha1.IssuerNamespaceSelector{
+						MatchNames: []string{"dev"},
+						Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"team": "dev"}},
+					},
+				},
+			},
+		},
+		"clusterissuer with an empty namespace selector": {
+			iss: &v1alpha1.ClusterIssuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig:      v1alpha1.IssuerConfig{SelfSigned: &v1alpha1.SelfSignedIssuer{}},
+					NamespaceSelector: &v1alpha1.IssuerNamespaceSelector{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath, "at least one of matchNames or selector must be specified"),
+			},
+		},
+		"clusterissuer with an invalid namespace name": {
+			iss: &v1alpha1.ClusterIssuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{SelfSigned: &v1alpha1.SelfSignedIssuer{}},
+					NamespaceSelector: &v1alpha1.IssuerNamespaceSelector{
+						MatchNames: []string{"Not_Valid"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("matchNames").Index(0), "Not_Valid", validation.IsDNS1123Label("Not_Valid")[0]),
+			},
+		},
+		"issuer with a namespace selector": {
+			iss: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{SelfSigned: &v1alpha1.SelfSignedIssuer{}},
+					NamespaceSelector: &v1alpha1.IssuerNamespaceSelector{
+						MatchNames: []string{"dev"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath, "may only be set on ClusterIssuers"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			var errs field.ErrorList
+			switch iss := s.iss.(type) {
+			case *v1alpha1.Issuer:
+				errs = ValidateIssuer(iss)
+			case *v1alpha1.ClusterIssuer:
+				errs = ValidateClusterIssuer(iss)
+			}
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -21,18 +21,36 @@ import (
 	"net/http"
 
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	restclient "k8s.io/client-go/rest"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
 )
 
 type CertificateAdmissionHook struct {
+	policyLister        cmlisters.CertificatePolicyLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	namespaceLister     corelisters.NamespaceLister
 }
 
 func (c *CertificateAdmissionHook) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	lister, err := newCertificatePolicyLister(kubeClientConfig, stopCh)
+	if err != nil {
+		return err
+	}
+	c.policyLister = lister
+
+	c.clusterIssuerLister, c.namespaceLister, err = newClusterIssuerNamespaceListers(kubeClientConfig, stopCh)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -58,7 +76,52 @@ func (c *CertificateAdmissionHook) Validate(admissionSpec *admissionv1beta1.Admi
 		return status
 	}
 
-	err = validation.ValidateCertificate(obj).ToAggregate()
+	el := validation.ValidateCertificate(obj)
+	if len(el) == 0 {
+		// CertificatePolicies and the namespaceSelector of ClusterIssuers
+		// are only enforced when the spec changes, so that existing
+		// Certificates can still be updated after they have been restricted
+		checkPolicies := true
+		if admissionSpec.Operation == admissionv1beta1.Update {
+			oldObj := &v1alpha1.Certificate{}
+			err := json.Unmarshal(admissionSpec.OldObject.Raw, oldObj)
+			if err != nil {
+				status.Allowed = false
+				status.Result = &metav1.Status{
+					Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+					Message: err.Error(),
+				}
+				return status
+			}
+			checkPolicies = certificateSpecChanged(oldObj, obj)
+		}
+		if checkPolicies {
+			policies, err := c.policyLister.List(labels.Everything())
+			if err != nil {
+				status.Allowed = false
+				status.Result = &metav1.Status{
+					Status: metav1.StatusFailure, Code: http.StatusInternalServerError, Reason: metav1.StatusReasonInternalError,
+					Message: err.Error(),
+				}
+				return status
+			}
+			el = validation.ValidateCertificateForPolicies(obj, policies)
+
+			nsErrs, err := validateClusterIssuerNamespace(c.clusterIssuerLister, c.namespaceLister,
+				obj.Spec.IssuerRef, obj.Namespace, field.NewPath("spec", "issuerRef"))
+			if err != nil {
+				status.Allowed = false
+				status.Result = &metav1.Status{
+					Status: metav1.StatusFailure, Code: http.StatusInternalServerError, Reason: metav1.StatusReasonInternalError,
+					Message: err.Error(),
+				}
+				return status
+			}
+			el = append(el, nsErrs...)
+		}
+	}
+
+	err = el.ToAggregate()
 	if err != nil {
 		status.Allowed = false
 		status.Result = &metav1.Status{
@@ -72,3 +135,13 @@ func (c *CertificateAdmissionHook) Validate(admissionSpec *admissionv1beta1.Admi
 
 	return status
 }
+
+// certificateSpecChanged returns true if the spec of the given Certificate
+// has changed, ignoring any defaults that have since been applied to it.
+func certificateSpecChanged(oldCrt, newCrt *v1alpha1.Certificate) bool {
+	oldCrt = oldCrt.DeepCopy()
+	newCrt = newCrt.DeepCopy()
+	v1alpha1.SetObjectDefaults_Certificate(oldCrt)
+	v1alpha1.SetObjectDefaults_Certificate(newCrt)
+	return !apiequality.Semantic.DeepEqual(oldCrt.Spec, newCrt.Spec)
+}
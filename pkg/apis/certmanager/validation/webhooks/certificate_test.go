@@ -0,0 +1,236 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+)
+
+func newTestPolicyLister(t *testing.T, policies ...*v1alpha1.CertificatePolicy) cmlisters.CertificatePolicyLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, p := range policies {
+		if err := indexer.Add(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cmlisters.NewCertificatePolicyLister(indexer)
+}
+
+func newTestClusterIssuerLister(t *testing.T, issuers ...*v1alpha1.ClusterIssuer) cmlisters.ClusterIssuerLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, iss := range issuers {
+		if err := indexer.Add(iss); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cmlisters.NewClusterIssuerLister(indexer)
+}
+
+func newTestNamespaceLister(t *testing.T, namespaces ...*corev1.Namespace) corelisters.NamespaceLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, ns := range namespaces {
+		if err := indexer.Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return corelisters.NewNamespaceLister(indexer)
+}
+
+func admissionRequest(t *testing.T, operation admissionv1beta1.Operation, oldObj, obj runtime.Object) *admissionv1beta1.AdmissionRequest {
+	req := &admissionv1beta1.AdmissionRequest{Operation: operation}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Object = runtime.RawExtension{Raw: data}
+	if oldObj != nil {
+		data, err := json.Marshal(oldObj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: data}
+	}
+	return req
+}
+
+func TestCertificateAdmissionHookPolicies(t *testing.T) {
+	permittedCrt := &v1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "dev"},
+		Spec: v1alpha1.CertificateSpec{
+			SecretName: "test",
+			DNSNames:   []string{"www.dev.corp"},
+			IssuerRef:  v1alpha1.ObjectReference{Name: "shared", Kind: v1alpha1.ClusterIssuerKind},
+		},
+	}
+	forbiddenCrt := permittedCrt.DeepCopy()
+	forbiddenCrt.Spec.DNSNames = []string{"www.payments.corp"}
+	labelledForbiddenCrt := forbiddenCrt.DeepCopy()
+	labelledForbiddenCrt.Labels = map[string]string{"test": "label"}
+	otherNamespaceCrt := forbiddenCrt.DeepCopy()
+	otherNamespaceCrt.Namespace = "prod"
+
+	policy := &v1alpha1.CertificatePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+		Spec: v1alpha1.CertificatePolicySpec{
+			Selector: v1alpha1.CertificatePolicySelector{
+				Namespaces: []string{"dev"},
+				IssuerRefs: []v1alpha1.ObjectReference{{Name: "shared", Kind: v1alpha1.ClusterIssuerKind}},
+			},
+			AllowedDNSNames: []string{"*.dev.corp"},
+		},
+	}
+
+	tests := map[string]struct {
+		operation     admissionv1beta1.Operation
+		oldObj, obj   *v1alpha1.Certificate
+		expectAllowed bool
+	}{
+		"should allow creating a certificate permitted by the policy": {
+			operation:     admissionv1beta1.Create,
+			obj:           permittedCrt,
+			expectAllowed: true,
+		},
+		"should reject creating a certificate not permitted by the policy": {
+			operation:     admissionv1beta1.Create,
+			obj:           forbiddenCrt,
+			expectAllowed: false,
+		},
+		"should allow creating a certificate in a namespace not selected by the policy": {
+			operation:     admissionv1beta1.Create,
+			obj:           otherNamespaceCrt,
+			expectAllowed: true,
+		},
+		"should reject changing the spec of a certificate to one not permitted by the policy": {
+			operation:     admissionv1beta1.Update,
+			oldObj:        permittedCrt,
+			obj:           forbiddenCrt,
+			expectAllowed: false,
+		},
+		"should allow updating the metadata of an existing certificate not permitted by the policy": {
+			operation:     admissionv1beta1.Update,
+			oldObj:        forbiddenCrt,
+			obj:           labelledForbiddenCrt,
+			expectAllowed: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			hook := &CertificateAdmissionHook{
+				policyLister:        newTestPolicyLister(t, policy),
+				clusterIssuerLister: newTestClusterIssuerLister(t),
+				namespaceLister:     newTestNamespaceLister(t),
+			}
+			var oldObj runtime.Object
+			if test.oldObj != nil {
+				oldObj = test.oldObj
+			}
+			resp := hook.Validate(admissionRequest(t, test.operation, oldObj, test.obj))
+			if resp.Allowed != test.expectAllowed {
+				t.Errorf("expected allowed=%t but got %t: %v", test.expectAllowed, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
+
+func TestCertificateAdmissionHookClusterIssuerNamespace(t *testing.T) {
+	crt := &v1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "dev"},
+		Spec: v1alpha1.CertificateSpec{
+			SecretName: "test",
+			DNSNames:   []string{"www.dev.corp"},
+			IssuerRef:  v1alpha1.ObjectReference{Name: "shared", Kind: v1alpha1.ClusterIssuerKind},
+		},
+	}
+	prodCrt := crt.DeepCopy()
+	prodCrt.Namespace = "prod"
+	missingIssuerCrt := prodCrt.DeepCopy()
+	missingIssuerCrt.Spec.IssuerRef.Name = "missing"
+	labelledProdCrt := prodCrt.DeepCopy()
+	labelledProdCrt.Labels = map[string]string{"test": "label"}
+
+	clusterIssuer := &v1alpha1.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+		Spec: v1alpha1.IssuerSpec{
+			IssuerConfig: v1alpha1.IssuerConfig{SelfSigned: &v1alpha1.SelfSignedIssuer{}},
+			NamespaceSelector: &v1alpha1.IssuerNamespaceSelector{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "dev"}},
+			},
+		},
+	}
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"team": "dev"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod"}},
+	}
+
+	tests := map[string]struct {
+		operation     admissionv1beta1.Operation
+		oldObj, obj   *v1alpha1.Certificate
+		expectAllowed bool
+	}{
+		"should allow creating a certificate in a namespace selected by the clusterissuer": {
+			operation:     admissionv1beta1.Create,
+			obj:           crt,
+			expectAllowed: true,
+		},
+		"should reject creating a certificate in a namespace not selected by the clusterissuer": {
+			operation:     admissionv1beta1.Create,
+			obj:           prodCrt,
+			expectAllowed: false,
+		},
+		"should allow creating a certificate referencing a clusterissuer that does not exist": {
+			operation:     admissionv1beta1.Create,
+			obj:           missingIssuerCrt,
+			expectAllowed: true,
+		},
+		"should allow updating the metadata of an existing certificate in a namespace not selected by the clusterissuer": {
+			operation:     admissionv1beta1.Update,
+			oldObj:        prodCrt,
+			obj:           labelledProdCrt,
+			expectAllowed: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			hook := &CertificateAdmissionHook{
+				policyLister:        newTestPolicyLister(t),
+				clusterIssuerLister: newTestClusterIssuerLister(t, clusterIssuer),
+				namespaceLister:     newTestNamespaceLister(t, namespaces...),
+			}
+			var oldObj runtime.Object
+			if test.oldObj != nil {
+				oldObj = test.oldObj
+			}
+			resp := hook.Validate(admissionRequest(t, test.operation, oldObj, test.obj))
+			if resp.Allowed != test.expectAllowed {
+				t.Errorf("expected allowed=%t but got %t: %v", test.expectAllowed, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/leki75/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+)
+
+type CertificatePolicyAdmissionHook struct {
+}
+
+func (c *CertificatePolicyAdmissionHook) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	return nil
+}
+
+func (c *CertificatePolicyAdmissionHook) ValidatingResource() (plural schema.GroupVersionResource, singular string) {
+	gv := v1alpha1.SchemeGroupVersion
+	gv.Group = "admission." + gv.Group
+	// override version to be the version of the admissionresponse resource
+	gv.Version = "v1beta1"
+	return gv.WithResource("certificatepolicies"), "certificatepolicy"
+}
+
+func (c *CertificatePolicyAdmissionHook) Validate(admissionSpec *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	status := &admissionv1beta1.AdmissionResponse{}
+
+	obj := &v1alpha1.CertificatePolicy{}
+	err := json.Unmarshal(admissionSpec.Object.Raw, obj)
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	err = validation.ValidateCertificatePolicy(obj).ToAggregate()
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusNotAcceptable, Reason: metav1.StatusReasonNotAcceptable,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	status.Allowed = true
+
+	return status
+}
+
+// newCertificatePolicyLister starts an informer for CertificatePolicy
+// resources, and returns a lister backed by its cache once it has synced.
+func newCertificatePolicyLister(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) (cmlisters.CertificatePolicyLister, error) {
+	cl, err := cmclient.NewForConfig(kubeClientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	factory := cminformers.NewSharedInformerFactory(cl, time.Second*30)
+	informer := factory.Certmanager().V1alpha1().CertificatePolicies()
+	// the informer must be requested before the factory is started
+	hasSynced := informer.Informer().HasSynced
+	factory.Start(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, hasSynced) {
+		return nil, fmt.Errorf("error waiting for CertificatePolicy cache to sync")
+	}
+
+	return informer.Lister(), nil
+}
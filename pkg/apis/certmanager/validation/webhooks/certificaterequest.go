@@ -0,0 +1,237 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+)
+
+const (
+	// signerResource is the virtual resource that users must be permitted to
+	// 'approve' in order to approve or deny a CertificateRequest.
+	signerResource = "signers"
+	approveVerb    = "approve"
+)
+
+type CertificateRequestAdmissionHook struct {
+	sarClient           authorizationclient.SubjectAccessReviewInterface
+	policyLister        cmlisters.CertificatePolicyLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	namespaceLister     corelisters.NamespaceLister
+}
+
+func (c *CertificateRequestAdmissionHook) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	cl, err := kubernetes.NewForConfig(kubeClientConfig)
+	if err != nil {
+		return err
+	}
+	c.sarClient = cl.AuthorizationV1().SubjectAccessReviews()
+
+	lister, err := newCertificatePolicyLister(kubeClientConfig, stopCh)
+	if err != nil {
+		return err
+	}
+	c.policyLister = lister
+
+	c.clusterIssuerLister, c.namespaceLister, err = newClusterIssuerNamespaceListers(kubeClientConfig, stopCh)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *CertificateRequestAdmissionHook) ValidatingResource() (plural schema.GroupVersionResource, singular string) {
+	gv := v1alpha1.SchemeGroupVersion
+	gv.Group = "admission." + gv.Group
+	// override version to be the version of the admissionresponse resource
+	gv.Version = "v1beta1"
+	return gv.WithResource("certificaterequests"), "certificaterequest"
+}
+
+func (c *CertificateRequestAdmissionHook) Validate(admissionSpec *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	status := &admissionv1beta1.AdmissionResponse{}
+
+	obj := &v1alpha1.CertificateRequest{}
+	err := json.Unmarshal(admissionSpec.Object.Raw, obj)
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	var el field.ErrorList
+	if admissionSpec.Operation == admissionv1beta1.Update {
+		oldObj := &v1alpha1.CertificateRequest{}
+		err := json.Unmarshal(admissionSpec.OldObject.Raw, oldObj)
+		if err != nil {
+			status.Allowed = false
+			status.Result = &metav1.Status{
+				Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+				Message: err.Error(),
+			}
+			return status
+		}
+		el = validation.ValidateCertificateRequestUpdate(oldObj, obj)
+		if len(el) == 0 && approvalConditionAdded(oldObj, obj) {
+			el = c.validateApprover(admissionSpec.UserInfo, obj)
+		}
+	} else {
+		el = validation.ValidateCertificateRequest(obj)
+		if len(el) == 0 {
+			// the spec is immutable, so CertificatePolicies and the
+			// namespaceSelector of ClusterIssuers only need to be checked
+			// when the CertificateRequest is created
+			policies, err := c.policyLister.List(labels.Everything())
+			if err != nil {
+				status.Allowed = false
+				status.Result = &metav1.Status{
+					Status: metav1.StatusFailure, Code: http.StatusInternalServerError, Reason: metav1.StatusReasonInternalError,
+					Message: err.Error(),
+				}
+				return status
+			}
+			el = validation.ValidateCertificateRequestForPolicies(obj, policies)
+
+			nsErrs, err := validateClusterIssuerNamespace(c.clusterIssuerLister, c.namespaceLister,
+				obj.Spec.IssuerRef, obj.Namespace, field.NewPath("spec", "issuerRef"))
+			if err != nil {
+				status.Allowed = false
+				status.Result = &metav1.Status{
+					Status: metav1.StatusFailure, Code: http.StatusInternalServerError, Reason: metav1.StatusReasonInternalError,
+					Message: err.Error(),
+				}
+				return status
+			}
+			el = append(el, nsErrs...)
+		}
+		if len(el) == 0 && approvalConditionAdded(&v1alpha1.CertificateRequest{}, obj) {
+			el = c.validateApprover(admissionSpec.UserInfo, obj)
+		}
+	}
+
+	err = el.ToAggregate()
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusNotAcceptable, Reason: metav1.StatusReasonNotAcceptable,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	status.Allowed = true
+
+	return status
+}
+
+// approvalConditionAdded returns true if newCR has an 'Approved' or 'Denied'
+// condition that oldCR does not.
+func approvalConditionAdded(oldCR, newCR *v1alpha1.CertificateRequest) bool {
+	has := func(cr *v1alpha1.CertificateRequest, t v1alpha1.CertificateRequestConditionType) bool {
+		for _, c := range cr.Status.Conditions {
+			if c.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+	for _, t := range []v1alpha1.CertificateRequestConditionType{v1alpha1.CertificateRequestConditionApproved, v1alpha1.CertificateRequestConditionDenied} {
+		if has(newCR, t) && !has(oldCR, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// signerNames returns the names of the 'signers' resource that a user must
+// be permitted to 'approve' in order to approve or deny the given
+// CertificateRequest. The first name identifies the referenced issuer, and
+// the second matches all issuers of the same kind.
+func signerNames(cr *v1alpha1.CertificateRequest) []string {
+	switch cr.Spec.IssuerRef.Kind {
+	case v1alpha1.ClusterIssuerKind:
+		return []string{
+			fmt.Sprintf("clusterissuers.%s/%s", v1alpha1.SchemeGroupVersion.Group, cr.Spec.IssuerRef.Name),
+			fmt.Sprintf("clusterissuers.%s/*", v1alpha1.SchemeGroupVersion.Group),
+		}
+	default:
+		return []string{
+			fmt.Sprintf("issuers.%s/%s.%s", v1alpha1.SchemeGroupVersion.Group, cr.Namespace, cr.Spec.IssuerRef.Name),
+			fmt.Sprintf("issuers.%s/*", v1alpha1.SchemeGroupVersion.Group),
+		}
+	}
+}
+
+// validateApprover checks that the given user is permitted to approve or
+// deny CertificateRequests that reference the issuer of the given
+// CertificateRequest.
+func (c *CertificateRequestAdmissionHook) validateApprover(user authenticationv1.UserInfo, cr *v1alpha1.CertificateRequest) field.ErrorList {
+	fldPath := field.NewPath("status", "conditions")
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	for _, name := range signerNames(cr) {
+		resp, err := c.sarClient.Create(&authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   user.Username,
+				Groups: user.Groups,
+				Extra:  extra,
+				UID:    user.UID,
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Group:     v1alpha1.SchemeGroupVersion.Group,
+					Resource:  signerResource,
+					Verb:      approveVerb,
+					Namespace: cr.Namespace,
+					Name:      name,
+				},
+			},
+		})
+		if err != nil {
+			return field.ErrorList{field.InternalError(fldPath, err)}
+		}
+		if resp.Status.Allowed {
+			return nil
+		}
+	}
+
+	return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("user %q is not permitted to %s %s %q",
+		user.Username, approveVerb, signerResource, signerNames(cr)[0]))}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func generateCSR(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"example.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func TestCertificateRequestAdmissionHookApproval(t *testing.T) {
+	baseCR := &v1alpha1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "testns"},
+		Spec: v1alpha1.CertificateRequestSpec{
+			CSRPEM:    generateCSR(t),
+			IssuerRef: v1alpha1.ObjectReference{Name: "ca", Kind: v1alpha1.IssuerKind},
+		},
+	}
+	approvedCR := baseCR.DeepCopy()
+	approvedCR.Status.Conditions = []v1alpha1.CertificateRequestCondition{
+		{Type: v1alpha1.CertificateRequestConditionApproved, Status: v1alpha1.ConditionTrue, Reason: "Approved"},
+	}
+	readyCR := approvedCR.DeepCopy()
+	readyCR.Status.Conditions = append(readyCR.Status.Conditions, v1alpha1.CertificateRequestCondition{
+		Type: v1alpha1.CertificateRequestConditionReady, Status: v1alpha1.ConditionTrue,
+	})
+	clusterIssuerCR := baseCR.DeepCopy()
+	clusterIssuerCR.Spec.IssuerRef.Kind = v1alpha1.ClusterIssuerKind
+	deniedClusterIssuerCR := clusterIssuerCR.DeepCopy()
+	deniedClusterIssuerCR.Status.Conditions = []v1alpha1.CertificateRequestCondition{
+		{Type: v1alpha1.CertificateRequestConditionDenied, Status: v1alpha1.ConditionTrue, Reason: "Denied"},
+	}
+
+	tests := map[string]struct {
+		oldObj, obj *v1alpha1.CertificateRequest
+		// allowedNames is the set of signer names the user may approve
+		allowedNames  []string
+		expectedSARs  int
+		expectAllowed bool
+	}{
+		"should not check the approver if the approval conditions are unchanged": {
+			oldObj:        approvedCR,
+			obj:           readyCR,
+			expectedSARs:  0,
+			expectAllowed: true,
+		},
+		"should allow a user that may approve the referenced issuer": {
+			oldObj:        baseCR,
+			obj:           approvedCR,
+			allowedNames:  []string{"issuers.certmanager.k8s.io/testns.ca"},
+			expectedSARs:  1,
+			expectAllowed: true,
+		},
+		"should allow a user that may approve all issuers": {
+			oldObj:        baseCR,
+			obj:           approvedCR,
+			allowedNames:  []string{"issuers.certmanager.k8s.io/*"},
+			expectedSARs:  2,
+			expectAllowed: true,
+		},
+		"should allow a user that may deny a referenced cluster issuer": {
+			oldObj:        clusterIssuerCR,
+			obj:           deniedClusterIssuerCR,
+			allowedNames:  []string{"clusterissuers.certmanager.k8s.io/ca"},
+			expectedSARs:  1,
+			expectAllowed: true,
+		},
+		"should reject a user that may only approve a different issuer": {
+			oldObj:        baseCR,
+			obj:           approvedCR,
+			allowedNames:  []string{"issuers.certmanager.k8s.io/testns.other"},
+			expectedSARs:  2,
+			expectAllowed: false,
+		},
+		"should reject a user that may approve an issuer of the same name in another kind": {
+			oldObj:        clusterIssuerCR,
+			obj:           deniedClusterIssuerCR,
+			allowedNames:  []string{"issuers.certmanager.k8s.io/testns.ca", "issuers.certmanager.k8s.io/*"},
+			expectedSARs:  2,
+			expectAllowed: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := kubefake.NewSimpleClientset()
+			var sars []*authorizationv1.SubjectAccessReview
+			cl.PrependReactor("create", "subjectaccessreviews", func(action coretesting.Action) (bool, runtime.Object, error) {
+				sar := action.(coretesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				sars = append(sars, sar)
+				attrs := sar.Spec.ResourceAttributes
+				if sar.Spec.User != "approver" || attrs.Group != "certmanager.k8s.io" ||
+					attrs.Resource != "signers" || attrs.Verb != "approve" || attrs.Namespace != "testns" {
+					return true, sar, nil
+				}
+				for _, n := range test.allowedNames {
+					if attrs.Name == n {
+						sar.Status.Allowed = true
+					}
+				}
+				return true, sar, nil
+			})
+			hook := &CertificateRequestAdmissionHook{
+				sarClient:    cl.AuthorizationV1().SubjectAccessReviews(),
+				policyLister: newTestPolicyLister(t),
+			}
+
+			objData, err := json.Marshal(test.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			oldObjData, err := json.Marshal(test.oldObj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp := hook.Validate(&admissionv1beta1.AdmissionRequest{
+				Operation: admissionv1beta1.Update,
+				UserInfo:  authenticationv1.UserInfo{Username: "approver"},
+				Object:    runtime.RawExtension{Raw: objData},
+				OldObject: runtime.RawExtension{Raw: oldObjData},
+			})
+
+			if resp.Allowed != test.expectAllowed {
+				t.Errorf("expected allowed=%t but got %t: %v", test.expectAllowed, resp.Allowed, resp.Result)
+			}
+			if len(sars) != test.expectedSARs {
+				t.Errorf("expected %d SubjectAccessReviews but got %d", test.expectedSARs, len(sars))
+			}
+		})
+	}
+}
+
+func TestCertificateRequestAdmissionHookPolicies(t *testing.T) {
+	cr := &v1alpha1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "dev"},
+		Spec: v1alpha1.CertificateRequestSpec{
+			CSRPEM:    generateCSR(t),
+			IssuerRef: v1alpha1.ObjectReference{Name: "ca"},
+		},
+	}
+	readyCR := cr.DeepCopy()
+	readyCR.Status.Conditions = []v1alpha1.CertificateRequestCondition{
+		{Type: v1alpha1.CertificateRequestConditionReady, Status: v1alpha1.ConditionTrue},
+	}
+	policy := &v1alpha1.CertificatePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+		Spec: v1alpha1.CertificatePolicySpec{
+			Selector:        v1alpha1.CertificatePolicySelector{Namespaces: []string{"dev"}},
+			AllowedDNSNames: []string{"*.dev.corp"},
+		},
+	}
+
+	clusterIssuerCR := cr.DeepCopy()
+	clusterIssuerCR.Namespace = "prod"
+	clusterIssuerCR.Spec.IssuerRef = v1alpha1.ObjectReference{Name: "shared", Kind: v1alpha1.ClusterIssuerKind}
+	clusterIssuer := &v1alpha1.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+		Spec: v1alpha1.IssuerSpec{
+			IssuerConfig:      v1alpha1.IssuerConfig{SelfSigned: &v1alpha1.SelfSignedIssuer{}},
+			NamespaceSelector: &v1alpha1.IssuerNamespaceSelector{MatchNames: []string{"dev"}},
+		},
+	}
+
+	tests := map[string]struct {
+		operation     admissionv1beta1.Operation
+		oldObj, obj   *v1alpha1.CertificateRequest
+		expectAllowed bool
+	}{
+		"should reject creating a certificate request in a namespace not permitted by the clusterissuer": {
+			operation:     admissionv1beta1.Create,
+			obj:           clusterIssuerCR,
+			expectAllowed: false,
+		},
+		"should reject creating a certificate request not permitted by a policy": {
+			operation:     admissionv1beta1.Create,
+			obj:           cr,
+			expectAllowed: false,
+		},
+		"should allow updating the status of an existing certificate request": {
+			operation:     admissionv1beta1.Update,
+			oldObj:        cr,
+			obj:           readyCR,
+			expectAllowed: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			hook := &CertificateRequestAdmissionHook{
+				policyLister:        newTestPolicyLister(t, policy),
+				clusterIssuerLister: newTestClusterIssuerLister(t, clusterIssuer),
+				namespaceLister:     newTestNamespaceLister(t),
+			}
+			var oldObj runtime.Object
+			if test.oldObj != nil {
+				oldObj = test.oldObj
+			}
+			resp := hook.Validate(admissionRequest(t, test.operation, oldObj, test.obj))
+			if resp.Allowed != test.expectAllowed {
+				t.Errorf("expected allowed=%t but got %t: %v", test.expectAllowed, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
@@ -18,15 +18,27 @@ package webhooks
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/leki75/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
 )
 
 type ClusterIssuerAdmissionHook struct {
@@ -72,3 +84,61 @@ func (c *ClusterIssuerAdmissionHook) Validate(admissionSpec *admissionv1beta1.Ad
 
 	return status
 }
+
+// newClusterIssuerNamespaceListers starts informers for ClusterIssuer and
+// Namespace resources, and returns listers backed by their caches once they
+// have synced.
+func newClusterIssuerNamespaceListers(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) (cmlisters.ClusterIssuerLister, corelisters.NamespaceLister, error) {
+	cmCl, err := cmclient.NewForConfig(kubeClientConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	kubeCl, err := kubernetes.NewForConfig(kubeClientConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cmFactory := cminformers.NewSharedInformerFactory(cmCl, time.Second*30)
+	kubeFactory := kubeinformers.NewSharedInformerFactory(kubeCl, time.Second*30)
+	clusterIssuerInformer := cmFactory.Certmanager().V1alpha1().ClusterIssuers()
+	namespaceInformer := kubeFactory.Core().V1().Namespaces()
+	// the informers must be requested before the factories are started
+	hasSynced := []cache.InformerSynced{
+		clusterIssuerInformer.Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
+	}
+	cmFactory.Start(stopCh)
+	kubeFactory.Start(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, hasSynced...) {
+		return nil, nil, fmt.Errorf("error waiting for ClusterIssuer and Namespace caches to sync")
+	}
+
+	return clusterIssuerInformer.Lister(), namespaceInformer.Lister(), nil
+}
+
+// validateClusterIssuerNamespace checks that the ClusterIssuer referenced by
+// issuerRef, if any, permits resources in the given namespace. References to
+// ClusterIssuers that do not exist are permitted, as the ClusterIssuer may be
+// created later, at which point the controller performs the same check.
+func validateClusterIssuerNamespace(clusterIssuerLister cmlisters.ClusterIssuerLister, namespaceLister corelisters.NamespaceLister,
+	issuerRef v1alpha1.ObjectReference, namespace string, fldPath *field.Path) (field.ErrorList, error) {
+	if issuerRef.Kind != v1alpha1.ClusterIssuerKind {
+		return nil, nil
+	}
+	iss, err := clusterIssuerLister.Get(issuerRef.Name)
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := issuer.NamespaceAllowed(namespaceLister, iss, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("ClusterIssuer %q does not permit namespace %q", issuerRef.Name, namespace))}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/appscode/jsonpatch"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/install"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+// MutationAdmissionHook applies the registered defaults to cert-manager
+// resources, so that the defaults are persisted on the resource.
+type MutationAdmissionHook struct {
+	scheme *runtime.Scheme
+}
+
+// NewMutationAdmissionHook returns a MutationAdmissionHook that defaults all
+// versions of the certmanager.k8s.io API group.
+func NewMutationAdmissionHook() *MutationAdmissionHook {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	return &MutationAdmissionHook{scheme: scheme}
+}
+
+func (c *MutationAdmissionHook) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	return nil
+}
+
+func (c *MutationAdmissionHook) MutatingResource() (plural schema.GroupVersionResource, singular string) {
+	gv := v1alpha1.SchemeGroupVersion
+	gv.Group = "admission." + gv.Group
+	// override version to be the version of the admissionresponse resource
+	gv.Version = "v1beta1"
+	return gv.WithResource("mutations"), "mutation"
+}
+
+func (c *MutationAdmissionHook) Admit(admissionSpec *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	status := &admissionv1beta1.AdmissionResponse{
+		UID: admissionSpec.UID,
+	}
+
+	gvk := schema.GroupVersionKind(admissionSpec.Kind)
+	obj, err := c.scheme.New(gvk)
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	err = json.Unmarshal(admissionSpec.Object.Raw, obj)
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	patch, err := c.defaultingPatch(obj)
+	if err != nil {
+		status.Allowed = false
+		status.Result = &metav1.Status{
+			Status: metav1.StatusFailure, Code: http.StatusInternalServerError, Reason: metav1.StatusReasonInternalError,
+			Message: err.Error(),
+		}
+		return status
+	}
+
+	status.Allowed = true
+	if patch != nil {
+		patchType := admissionv1beta1.PatchTypeJSONPatch
+		status.PatchType = &patchType
+		status.Patch = patch
+	}
+
+	return status
+}
+
+// defaultingPatch returns a JSON patch that applies the defaults registered
+// for obj, or nil if obj does not need to be defaulted.
+// The patch is computed against the decoded object rather than the original
+// request so that it never removes fields that are not known to this version
+// of cert-manager.
+func (c *MutationAdmissionHook) defaultingPatch(obj runtime.Object) ([]byte, error) {
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	c.scheme.Default(obj)
+	defaulted, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := jsonpatch.CreatePatch(original, defaulted)
+	if err != nil {
+		return nil, err
+	}
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(ops)
+}
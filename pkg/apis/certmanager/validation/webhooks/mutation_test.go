@@ -0,0 +1,204 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha2"
+)
+
+func TestMutationAdmissionHook(t *testing.T) {
+	duration := func(d time.Duration) *metav1.Duration {
+		return &metav1.Duration{Duration: d}
+	}
+	crtKind := metav1.GroupVersionKind{Group: v1alpha1.SchemeGroupVersion.Group, Version: "v1alpha1", Kind: v1alpha1.CertificateKind}
+	crKind := metav1.GroupVersionKind{Group: v1alpha1.SchemeGroupVersion.Group, Version: "v1alpha1", Kind: v1alpha1.CertificateRequestKind}
+	crtV2Kind := metav1.GroupVersionKind{Group: v1alpha2.SchemeGroupVersion.Group, Version: "v1alpha2", Kind: v1alpha2.CertificateKind}
+
+	tests := map[string]struct {
+		kind          metav1.GroupVersionKind
+		obj           runtime.Object
+		expected      runtime.Object
+		expectPatch   bool
+		expectAllowed bool
+	}{
+		"should default all unset fields on a Certificate": {
+			kind: crtKind,
+			obj: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					SecretName: "test",
+					IssuerRef:  v1alpha1.ObjectReference{Name: "test"},
+				},
+			},
+			expected: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					SecretName:   "test",
+					IssuerRef:    v1alpha1.ObjectReference{Name: "test", Kind: v1alpha1.IssuerKind},
+					KeyAlgorithm: v1alpha1.RSAKeyAlgorithm,
+					KeySize:      v1alpha1.DefaultRSAKeySize,
+					KeyEncoding:  v1alpha1.PKCS1,
+					Duration:     duration(v1alpha1.DefaultCertificateDuration),
+				},
+			},
+			expectPatch:   true,
+			expectAllowed: true,
+		},
+		"should default the key size of an ECDSA Certificate": {
+			kind: crtKind,
+			obj: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					SecretName:   "test",
+					IssuerRef:    v1alpha1.ObjectReference{Name: "test", Kind: v1alpha1.ClusterIssuerKind},
+					KeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm,
+					KeyEncoding:  v1alpha1.PKCS8,
+					Duration:     duration(time.Hour * 24),
+					RenewBefore:  duration(time.Hour),
+				},
+			},
+			expected: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					SecretName:   "test",
+					IssuerRef:    v1alpha1.ObjectReference{Name: "test", Kind: v1alpha1.ClusterIssuerKind},
+					KeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm,
+					KeySize:      v1alpha1.DefaultECDSAKeySize,
+					KeyEncoding:  v1alpha1.PKCS8,
+					Duration:     duration(time.Hour * 24),
+					RenewBefore:  duration(time.Hour),
+				},
+			},
+			expectPatch:   true,
+			expectAllowed: true,
+		},
+		"should not patch a Certificate that has all defaults set": {
+			kind: crtKind,
+			obj: &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					SecretName:   "test",
+					IssuerRef:    v1alpha1.ObjectReference{Name: "test", Kind: v1alpha1.IssuerKind},
+					KeyAlgorithm: v1alpha1.RSAKeyAlgorithm,
+					KeySize:      4096,
+					KeyEncoding:  v1alpha1.PKCS1,
+					Duration:     duration(v1alpha1.DefaultCertificateDuration),
+					RenewBefore:  duration(v1alpha1.DefaultRenewBefore),
+				},
+			},
+			expectPatch:   false,
+			expectAllowed: true,
+		},
+		"should default a v1alpha2 Certificate": {
+			kind: crtV2Kind,
+			obj: &v1alpha2.Certificate{
+				Spec: v1alpha2.CertificateSpec{
+					SecretName:   "test",
+					IssuerRef:    v1alpha2.ObjectReference{Name: "test"},
+					KeyAlgorithm: v1alpha2.Ed25519KeyAlgorithm,
+				},
+			},
+			expected: &v1alpha2.Certificate{
+				Spec: v1alpha2.CertificateSpec{
+					SecretName:   "test",
+					IssuerRef:    v1alpha2.ObjectReference{Name: "test", Kind: v1alpha2.IssuerKind},
+					KeyAlgorithm: v1alpha2.Ed25519KeyAlgorithm,
+					KeyEncoding:  v1alpha2.PKCS8,
+					Duration:     duration(v1alpha2.DefaultCertificateDuration),
+				},
+			},
+			expectPatch:   true,
+			expectAllowed: true,
+		},
+		"should default a CertificateRequest": {
+			kind: crKind,
+			obj: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    []byte("csr"),
+					IssuerRef: v1alpha1.ObjectReference{Name: "test"},
+				},
+			},
+			expected: &v1alpha1.CertificateRequest{
+				Spec: v1alpha1.CertificateRequestSpec{
+					CSRPEM:    []byte("csr"),
+					IssuerRef: v1alpha1.ObjectReference{Name: "test", Kind: v1alpha1.IssuerKind},
+					Duration:  duration(v1alpha1.DefaultCertificateDuration),
+				},
+			},
+			expectPatch:   true,
+			expectAllowed: true,
+		},
+		"should reject an unknown kind": {
+			kind:          metav1.GroupVersionKind{Group: v1alpha1.SchemeGroupVersion.Group, Version: "v1alpha1", Kind: "Unknown"},
+			obj:           &v1alpha1.Certificate{},
+			expectAllowed: false,
+		},
+	}
+
+	hook := NewMutationAdmissionHook()
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			raw, err := json.Marshal(test.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := hook.Admit(&admissionv1beta1.AdmissionRequest{
+				UID:    "test",
+				Kind:   test.kind,
+				Object: runtime.RawExtension{Raw: raw},
+			})
+			if resp.UID != "test" {
+				t.Errorf("expected response UID %q, got %q", "test", resp.UID)
+			}
+			if resp.Allowed != test.expectAllowed {
+				t.Fatalf("expected allowed to be %t, got %t: %v", test.expectAllowed, resp.Allowed, resp.Result)
+			}
+			if !test.expectPatch {
+				if resp.Patch != nil {
+					t.Errorf("expected no patch, got %s", resp.Patch)
+				}
+				return
+			}
+			if resp.PatchType == nil || *resp.PatchType != admissionv1beta1.PatchTypeJSONPatch {
+				t.Errorf("expected patch type %q", admissionv1beta1.PatchTypeJSONPatch)
+			}
+
+			patch, err := jsonpatch.DecodePatch(resp.Patch)
+			if err != nil {
+				t.Fatalf("error decoding patch: %v", err)
+			}
+			patched, err := patch.Apply(raw)
+			if err != nil {
+				t.Fatalf("error applying patch: %v", err)
+			}
+			out := reflect.New(reflect.TypeOf(test.expected).Elem()).Interface()
+			if err := json.Unmarshal(patched, out); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(out, test.expected) {
+				t.Errorf("expected patched object %#v, got %#v", test.expected, out)
+			}
+		})
+	}
+}
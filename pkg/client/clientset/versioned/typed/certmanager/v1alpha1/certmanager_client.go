@@ -27,7 +27,9 @@ import (
 
 type CertmanagerV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ApprovalPoliciesGetter
 	CertificatesGetter
+	CertificatePoliciesGetter
 	CertificateRequestsGetter
 	ChallengesGetter
 	ClusterIssuersGetter
@@ -40,10 +42,18 @@ type CertmanagerV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *CertmanagerV1alpha1Client) ApprovalPolicies() ApprovalPolicyInterface {
+	return newApprovalPolicies(c)
+}
+
 func (c *CertmanagerV1alpha1Client) Certificates(namespace string) CertificateInterface {
 	return newCertificates(c, namespace)
 }
 
+func (c *CertmanagerV1alpha1Client) CertificatePolicies() CertificatePolicyInterface {
+	return newCertificatePolicies(c)
+}
+
 func (c *CertmanagerV1alpha1Client) CertificateRequests(namespace string) CertificateRequestInterface {
 	return newCertificateRequests(c, namespace)
 }
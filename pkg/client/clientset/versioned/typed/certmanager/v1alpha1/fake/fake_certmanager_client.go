@@ -28,10 +28,18 @@ type FakeCertmanagerV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeCertmanagerV1alpha1) ApprovalPolicies() v1alpha1.ApprovalPolicyInterface {
+	return &FakeApprovalPolicies{c}
+}
+
 func (c *FakeCertmanagerV1alpha1) Certificates(namespace string) v1alpha1.CertificateInterface {
 	return &FakeCertificates{c, namespace}
 }
 
+func (c *FakeCertmanagerV1alpha1) CertificatePolicies() v1alpha1.CertificatePolicyInterface {
+	return &FakeCertificatePolicies{c}
+}
+
 func (c *FakeCertmanagerV1alpha1) CertificateRequests(namespace string) v1alpha1.CertificateRequestInterface {
 	return &FakeCertificateRequests{c, namespace}
 }
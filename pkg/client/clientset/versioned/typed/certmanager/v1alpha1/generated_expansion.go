@@ -18,8 +18,12 @@ limitations under the License.
 
 package v1alpha1
 
+type ApprovalPolicyExpansion interface{}
+
 type CertificateExpansion interface{}
 
+type CertificatePolicyExpansion interface{}
+
 type CertificateRequestExpansion interface{}
 
 type ChallengeExpansion interface{}
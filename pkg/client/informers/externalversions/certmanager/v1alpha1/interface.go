@@ -24,8 +24,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ApprovalPolicies returns a ApprovalPolicyInformer.
+	ApprovalPolicies() ApprovalPolicyInformer
 	// Certificates returns a CertificateInformer.
 	Certificates() CertificateInformer
+	// CertificatePolicies returns a CertificatePolicyInformer.
+	CertificatePolicies() CertificatePolicyInformer
 	// CertificateRequests returns a CertificateRequestInformer.
 	CertificateRequests() CertificateRequestInformer
 	// Challenges returns a ChallengeInformer.
@@ -49,11 +53,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ApprovalPolicies returns a ApprovalPolicyInformer.
+func (v *version) ApprovalPolicies() ApprovalPolicyInformer {
+	return &approvalPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Certificates returns a CertificateInformer.
 func (v *version) Certificates() CertificateInformer {
 	return &certificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// CertificatePolicies returns a CertificatePolicyInformer.
+func (v *version) CertificatePolicies() CertificatePolicyInformer {
+	return &certificatePolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // CertificateRequests returns a CertificateRequestInformer.
 func (v *version) CertificateRequests() CertificateRequestInformer {
 	return &certificateRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
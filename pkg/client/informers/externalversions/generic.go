@@ -53,8 +53,12 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=certmanager.k8s.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("approvalpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1alpha1().ApprovalPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("certificates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1alpha1().Certificates().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("certificatepolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1alpha1().CertificatePolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("certificaterequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1alpha1().CertificateRequests().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("challenges"):
@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+// ApprovalPolicyListerExpansion allows custom methods to be added to
+// ApprovalPolicyLister.
+type ApprovalPolicyListerExpansion interface{}
+
 // CertificateListerExpansion allows custom methods to be added to
 // CertificateLister.
 type CertificateListerExpansion interface{}
@@ -26,6 +30,10 @@ type CertificateListerExpansion interface{}
 // CertificateNamespaceLister.
 type CertificateNamespaceListerExpansion interface{}
 
+// CertificatePolicyListerExpansion allows custom methods to be added to
+// CertificatePolicyLister.
+type CertificatePolicyListerExpansion interface{}
+
 // CertificateRequestListerExpansion allows custom methods to be added to
 // CertificateRequestLister.
 type CertificateRequestListerExpansion interface{}
@@ -36,6 +36,7 @@ import (
 	"github.com/leki75/cert-manager/pkg/issuer"
 	"github.com/leki75/cert-manager/pkg/issuer/acme/dns"
 	"github.com/leki75/cert-manager/pkg/issuer/acme/http"
+	"github.com/leki75/cert-manager/pkg/issuer/acme/tlsalpn"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 )
 
@@ -54,8 +55,9 @@ type controller struct {
 	// ACME challenge solvers are instantiated once at the time of controller
 	// construction.
 	// This also allows for easy mocking of the different challenge mechanisms.
-	dnsSolver  solver
-	httpSolver solver
+	dnsSolver     solver
+	httpSolver    solver
+	tlsALPNSolver solver
 	// scheduler marks challenges as Processing=true if they can be scheduled
 	// for processing. This job runs periodically every N seconds, so it cannot
 	// be constructed as a traditional controller.
@@ -87,8 +89,8 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	challengeInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().Challenges()
 	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().Issuers()
 	secretInformer := ctx.KubeSharedInformerFactory.Core().V1().Secrets()
-	// we register these informers here so the HTTP01 solver has a synced
-	// cache when managing pod/service/ingress resources
+	// we register these informers here so the HTTP01 and TLSALPN01 solvers
+	// have a synced cache when managing pod/service/ingress resources
 	podInformer := ctx.KubeSharedInformerFactory.Core().V1().Pods()
 	serviceInformer := ctx.KubeSharedInformerFactory.Core().V1().Services()
 	ingressInformer := ctx.KubeSharedInformerFactory.Extensions().V1beta1().Ingresses()
@@ -125,6 +127,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.recorder = ctx.Recorder
 	c.cmClient = ctx.CMClient
 	c.httpSolver = http.NewSolver(ctx)
+	c.tlsALPNSolver = tlsalpn.NewSolver(ctx)
 	var err error
 	c.dnsSolver, err = dns.NewSolver(ctx)
 	if err != nil {
@@ -160,10 +163,11 @@ func (c *controller) runScheduler(ctx context.Context) {
 
 	for _, ch := range toSchedule {
 		log := logf.WithResource(log, ch)
+		old := ch
 		ch = ch.DeepCopy()
 		ch.Status.Processing = true
 
-		_, err := c.cmClient.CertmanagerV1alpha1().Challenges(ch.Namespace).Update(ch)
+		err := c.updateChallengeStatus(old, ch)
 		if err != nil {
 			log.Error(err, "error scheduling challenge for processing")
 			return
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
@@ -65,12 +66,21 @@ func (c *controller) Sync(ctx context.Context, ch *cmapi.Challenge) (err error)
 	ch = ch.DeepCopy()
 
 	defer func() {
+		// finalizers can only be removed by updating the resource itself, and
+		// any changes to the status of a Challenge that is being deleted can
+		// be discarded.
+		if len(oldChal.Finalizers) != len(ch.Finalizers) {
+			_, updateErr := c.cmClient.CertmanagerV1alpha1().Challenges(ch.Namespace).Update(ch)
+			if updateErr != nil {
+				err = utilerrors.NewAggregate([]error{err, updateErr})
+			}
+			return
+		}
 		// TODO: replace with more efficient comparison
-		if reflect.DeepEqual(oldChal.Status, ch.Status) && len(oldChal.Finalizers) == len(ch.Finalizers) {
+		if reflect.DeepEqual(oldChal.Status, ch.Status) {
 			return
 		}
-		_, updateErr := c.cmClient.CertmanagerV1alpha1().Challenges(ch.Namespace).Update(ch)
-		if err != nil {
+		if updateErr := c.updateChallengeStatus(oldChal, ch); updateErr != nil {
 			err = utilerrors.NewAggregate([]error{err, updateErr})
 		}
 	}()
@@ -351,12 +361,35 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 	return nil
 }
 
+// updateChallengeStatus will update the status of the given Challenge using
+// the /status subresource.
+func (c *controller) updateChallengeStatus(old, new *cmapi.Challenge) error {
+	return controllerpkg.RetryStatusUpdate(func() error {
+		_, err := c.cmClient.CertmanagerV1alpha1().Challenges(new.Namespace).UpdateStatus(new)
+		return err
+	}, func() (bool, error) {
+		latest, err := c.cmClient.CertmanagerV1alpha1().Challenges(new.Namespace).Get(new.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(latest.Status, old.Status) {
+			return false, nil
+		}
+		status := new.Status
+		new = latest.DeepCopy()
+		new.Status = status
+		return true, nil
+	})
+}
+
 func (c *controller) solverFor(challengeType string) (solver, error) {
 	switch challengeType {
 	case "http-01":
 		return c.httpSolver, nil
 	case "dns-01":
 		return c.dnsSolver, nil
+	case "tls-alpn-01":
+		return c.tlsALPNSolver, nil
 	}
 	return nil, fmt.Errorf("no solver for %q implemented", challengeType)
 }
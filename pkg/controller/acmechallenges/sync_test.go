@@ -80,7 +80,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengeURL("testurl"),
 				)},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), gen.DefaultTestNamespace,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), "status", gen.DefaultTestNamespace,
 						gen.Challenge("testchal",
 							gen.SetChallengeProcessing(true),
 							gen.SetChallengeURL("testurl"),
@@ -121,7 +121,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengeType("http-01"),
 				)},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), gen.DefaultTestNamespace,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), "status", gen.DefaultTestNamespace,
 						gen.Challenge("testchal",
 							gen.SetChallengeProcessing(true),
 							gen.SetChallengeURL("testurl"),
@@ -163,7 +163,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengePresented(true),
 				)},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), gen.DefaultTestNamespace,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), "status", gen.DefaultTestNamespace,
 						gen.Challenge("testchal",
 							gen.SetChallengeProcessing(true),
 							gen.SetChallengeURL("testurl"),
@@ -215,7 +215,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengePresented(true),
 				)},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), gen.DefaultTestNamespace,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), "status", gen.DefaultTestNamespace,
 						gen.Challenge("testchal",
 							gen.SetChallengeProcessing(true),
 							gen.SetChallengeURL("testurl"),
@@ -271,7 +271,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengePresented(true),
 				)},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), gen.DefaultTestNamespace,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), "status", gen.DefaultTestNamespace,
 						gen.Challenge("testchal",
 							gen.SetChallengeProcessing(false),
 							gen.SetChallengeURL("testurl"),
@@ -305,7 +305,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengePresented(true),
 				)},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), gen.DefaultTestNamespace,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("challenges"), "status", gen.DefaultTestNamespace,
 						gen.Challenge("testchal",
 							gen.SetChallengeProcessing(false),
 							gen.SetChallengeURL("testurl"),
@@ -33,6 +33,7 @@ import (
 	"github.com/leki75/cert-manager/pkg/acme"
 	acmecl "github.com/leki75/cert-manager/pkg/acme/client"
 	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
 	"github.com/leki75/cert-manager/pkg/controller/acmeorders/selectors"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 	"github.com/leki75/cert-manager/pkg/metrics"
@@ -66,9 +67,9 @@ func (c *controller) Sync(ctx context.Context, o *cmapi.Order) (err error) {
 			return
 		}
 		log.Info("updating Order resource status")
-		_, updateErr := c.cmClient.CertmanagerV1alpha1().Orders(o.Namespace).Update(o)
-		if err != nil {
-			log.Error(err, "failed to update status")
+		updateErr := c.updateOrderStatus(oldOrder, o)
+		if updateErr != nil {
+			log.Error(updateErr, "failed to update status")
 			err = utilerrors.NewAggregate([]error{err, updateErr})
 			return
 		}
@@ -439,6 +440,8 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 				return ch
 			case ch.Type == "dns-01" && solver.DNS01 != nil:
 				return ch
+			case ch.Type == "tls-alpn-01" && solver.TLSALPN01 != nil:
+				return ch
 			}
 		}
 		return nil
@@ -648,6 +651,10 @@ func keyForChallenge(cl acmecl.Interface, challenge *acmeapi.Challenge) (string,
 		return cl.HTTP01ChallengeResponse(challenge.Token)
 	case "dns-01":
 		return cl.DNS01ChallengeRecord(challenge.Token)
+	case "tls-alpn-01":
+		// the key authorization presented in tls-alpn-01 validation
+		// certificates is the same as the http-01 challenge response
+		return cl.HTTP01ChallengeResponse(challenge.Token)
 	default:
 		err = fmt.Errorf("unsupported challenge type %s", challenge.Type)
 	}
@@ -758,6 +765,27 @@ func (c *controller) setOrderState(o *cmapi.OrderStatus, s cmapi.State) {
 	}
 }
 
+// updateOrderStatus will update the status of the given Order using the
+// /status subresource.
+func (c *controller) updateOrderStatus(old, new *cmapi.Order) error {
+	return controllerpkg.RetryStatusUpdate(func() error {
+		_, err := c.cmClient.CertmanagerV1alpha1().Orders(new.Namespace).UpdateStatus(new)
+		return err
+	}, func() (bool, error) {
+		latest, err := c.cmClient.CertmanagerV1alpha1().Orders(new.Namespace).Get(new.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(latest.Status, old.Status) {
+			return false, nil
+		}
+		status := new.Status
+		new = latest.DeepCopy()
+		new.Status = status
+		return true, nil
+	})
+}
+
 func (c *controller) storeCertificateOnStatus(o *cmapi.Order, certs [][]byte) error {
 	// encode the retrieved certificates (including the chain)
 	certBuffer := bytes.NewBuffer([]byte{})
@@ -149,7 +149,7 @@ dGVzdA==
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{testOrder},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), testOrderPending.Namespace, testOrderPending)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), "status", testOrderPending.Namespace, testOrderPending)),
 				},
 			},
 			Client: &acmecl.FakeACME{
@@ -200,7 +200,7 @@ dGVzdA==
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{testOrderPending, testAuthorizationChallengeValid},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), testOrderReady.Namespace, testOrderReady)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), "status", testOrderReady.Namespace, testOrderReady)),
 				},
 			},
 			Client: &acmecl.FakeACME{
@@ -217,7 +217,7 @@ dGVzdA==
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{testOrderValid, testAuthorizationChallengeValid},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), testOrderValid.Namespace, testOrderValid)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), "status", testOrderValid.Namespace, testOrderValid)),
 				},
 			},
 			Client: &acmecl.FakeACME{
@@ -238,7 +238,7 @@ dGVzdA==
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{testOrderPending, testAuthorizationChallengeInvalid},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), testOrderInvalid.Namespace, testOrderInvalid)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), "status", testOrderInvalid.Namespace, testOrderInvalid)),
 				},
 			},
 			Client: &acmecl.FakeACME{
@@ -340,6 +340,9 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 			},
 		},
 	}
+	emptySelectorSolverTLSALPN01 := v1alpha1.ACMEChallengeSolver{
+		TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{},
+	}
 	nonMatchingSelectorSolver := v1alpha1.ACMEChallengeSolver{
 		Selector: &v1alpha1.CertificateDNSNameSelector{
 			MatchLabels: map[string]string{
@@ -372,6 +375,10 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 		Type:  "dns-01",
 		Token: "dns-01-token",
 	}
+	acmeChallengeTLSALPN01 := &acmeapi.Challenge{
+		Type:  "tls-alpn-01",
+		Token: "tls-alpn-01-token",
+	}
 
 	tests := map[string]struct {
 		acmeClient acmecl.Interface
@@ -412,6 +419,36 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 				Solver:  &emptySelectorSolverHTTP01,
 			},
 		},
+		"should use the tls-alpn-01 solver for a tls-alpn-01 challenge": {
+			acmeClient: basicACMEClient,
+			issuer: &v1alpha1.Issuer{
+				Spec: v1alpha1.IssuerSpec{
+					IssuerConfig: v1alpha1.IssuerConfig{
+						ACME: &v1alpha1.ACMEIssuer{
+							Solvers: []v1alpha1.ACMEChallengeSolver{emptySelectorSolverHTTP01, emptySelectorSolverTLSALPN01},
+						},
+					},
+				},
+			},
+			order: &v1alpha1.Order{
+				Spec: v1alpha1.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &acmeapi.Authorization{
+				Identifier: acmeapi.AuthzID{
+					Value: "example.com",
+				},
+				Challenges: []*acmeapi.Challenge{acmeChallengeTLSALPN01},
+			},
+			expectedChallengeSpec: &v1alpha1.ChallengeSpec{
+				Type:    "tls-alpn-01",
+				DNSName: "example.com",
+				Token:   acmeChallengeTLSALPN01.Token,
+				Key:     "http01",
+				Solver:  &emptySelectorSolverTLSALPN01,
+			},
+		},
 		"should use configured default solver when no others are present but selector is non-nil": {
 			acmeClient: basicACMEClient,
 			issuer: &v1alpha1.Issuer{
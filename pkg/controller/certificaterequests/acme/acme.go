@@ -0,0 +1,46 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
+	"github.com/leki75/cert-manager/pkg/controller/certificaterequests"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-acme"
+)
+
+func init() {
+	// create certificate request controller for ACME issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		// watch Orders so that changes to the Order created for a
+		// CertificateRequest will trigger it to be resynced
+		controller := certificaterequests.New(apiutil.IssuerACME, func(ctx *controllerpkg.Context) cache.SharedIndexInformer {
+			return ctx.SharedInformerFactory.Certmanager().V1alpha1().Orders().Informer()
+		})
+
+		c, err := controllerpkg.New(ctx, CRControllerName, controller)
+		if err != nil {
+			return nil, err
+		}
+		return c.Run, nil
+	})
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "certificaterequests-approver"
+)
+
+var keyFunc = controllerpkg.KeyFunc
+
+// controller approves CertificateRequests that are matched by an
+// ApprovalPolicy.
+type controller struct {
+	certificateRequestLister cmlisters.CertificateRequestLister
+	approvalPolicyLister     cmlisters.ApprovalPolicyLister
+
+	queue workqueue.RateLimitingInterface
+
+	// logger to be used by this controller
+	log logr.Logger
+
+	// clientset used to update cert-manager API resources
+	cmClient cmclient.Interface
+
+	// used to record Events about resources to the API
+	recorder record.EventRecorder
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+
+	// create a queue used to queue up items to be processed
+	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().CertificateRequests()
+	approvalPolicyInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().ApprovalPolicies()
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		approvalPolicyInformer.Informer().HasSynced,
+	}
+
+	// set all the references to the listers for used by the Sync function
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.approvalPolicyLister = approvalPolicyInformer.Lister()
+
+	// register handler functions
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	approvalPolicyInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleApprovalPolicy})
+
+	c.cmClient = ctx.CMClient
+	c.recorder = ctx.Recorder
+
+	return c.queue, mustSync, nil
+}
+
+// handleApprovalPolicy enqueues all CertificateRequests when an
+// ApprovalPolicy changes, as any of them may now be approved.
+func (c *controller) handleApprovalPolicy(obj interface{}) {
+	log := c.log.WithName("handleApprovalPolicy")
+
+	crs, err := c.certificateRequestLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing certificate requests")
+		return
+	}
+	for _, cr := range crs {
+		key, err := keyFunc(cr)
+		if err != nil {
+			logf.WithResource(log, cr).Error(err, "error computing key for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			log.Error(err, "certificate request in work queue no longer exists")
+			return nil
+		}
+
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
+	return c.Sync(ctx, cr)
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		c, err := controllerpkg.New(ctx, ControllerName, &controller{})
+		if err != nil {
+			return nil, err
+		}
+		return c.Run, nil
+	})
+}
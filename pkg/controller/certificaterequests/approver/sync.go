@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+const (
+	reasonApprovalPolicy = "ApprovalPolicy"
+)
+
+// Sync will approve the given CertificateRequest if it is matched by an
+// ApprovalPolicy. CertificateRequests that have already been approved or
+// denied are not modified.
+func (c *controller) Sync(ctx context.Context, cr *v1alpha1.CertificateRequest) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	if apiutil.CertificateRequestIsApproved(cr) || apiutil.CertificateRequestIsDenied(cr) {
+		dbg.Info("certificate request has already been approved or denied")
+		return nil
+	}
+
+	policy, err := c.approvalPolicyFor(cr)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		dbg.Info("no approval policy matches certificate request")
+		return nil
+	}
+	log = logf.WithRelatedResource(log, policy)
+
+	crCopy := cr.DeepCopy()
+	message := fmt.Sprintf("Approved by ApprovalPolicy %q", policy.Name)
+	apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionApproved, v1alpha1.ConditionTrue, reasonApprovalPolicy, message)
+
+	err = controllerpkg.RetryStatusUpdate(func() (err error) {
+		_, err = c.cmClient.CertmanagerV1alpha1().CertificateRequests(crCopy.Namespace).UpdateStatus(crCopy)
+		return err
+	}, func() (bool, error) {
+		latest, err := c.cmClient.CertmanagerV1alpha1().CertificateRequests(crCopy.Namespace).Get(crCopy.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(latest.Status, cr.Status) {
+			return false, nil
+		}
+		status := crCopy.Status
+		crCopy = latest.DeepCopy()
+		crCopy.Status = status
+		return true, nil
+	})
+	if err != nil {
+		log.Error(err, "error approving certificate request")
+		return err
+	}
+
+	log.Info("approved certificate request")
+	c.recorder.Event(cr, corev1.EventTypeNormal, reasonApprovalPolicy, message)
+
+	return nil
+}
+
+// approvalPolicyFor returns the first ApprovalPolicy, ordered by name, that
+// matches the given CertificateRequest. If no policy matches, nil is
+// returned.
+func (c *controller) approvalPolicyFor(cr *v1alpha1.CertificateRequest) (*v1alpha1.ApprovalPolicy, error) {
+	policies, err := c.approvalPolicyLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	for _, p := range policies {
+		matches, err := apiutil.ApprovalPolicyMatches(p, cr)
+		if err != nil {
+			c.recorder.Eventf(p, corev1.EventTypeWarning, "BadConfig", "Invalid selector: %v", err)
+			continue
+		}
+		if matches {
+			return p, nil
+		}
+	}
+
+	return nil, nil
+}
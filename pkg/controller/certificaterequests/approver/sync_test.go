@@ -0,0 +1,169 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	realclock "k8s.io/utils/clock"
+	clock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func TestSync(t *testing.T) {
+	nowTime := time.Now()
+	nowMetaTime := metav1.NewTime(nowTime)
+	apiutil.Clock = clock.NewFakeClock(nowTime)
+	defer func() { apiutil.Clock = realclock.RealClock{} }()
+
+	exampleCR := gen.CertificateRequest("test",
+		gen.SetCertificateRequestIssuer(v1alpha1.ObjectReference{Name: "ca"}),
+		gen.SetCertificateRequestLabels(map[string]string{"app": "test"}),
+	)
+	exampleApprovedCR := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestStatusCondition(v1alpha1.CertificateRequestCondition{
+			Type:               v1alpha1.CertificateRequestConditionApproved,
+			Status:             v1alpha1.ConditionTrue,
+			Reason:             "ApprovalPolicy",
+			Message:            `Approved by ApprovalPolicy "policy"`,
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+	exampleDeniedCR := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestStatusCondition(v1alpha1.CertificateRequestCondition{
+			Type:   v1alpha1.CertificateRequestConditionDenied,
+			Status: v1alpha1.ConditionTrue,
+			Reason: "Denied",
+		}),
+	)
+
+	approveAction := testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+		v1alpha1.SchemeGroupVersion.WithResource("certificaterequests"),
+		"status",
+		gen.DefaultTestNamespace,
+		exampleApprovedCR,
+	))
+
+	tests := map[string]struct {
+		cr              *v1alpha1.CertificateRequest
+		policies        []runtime.Object
+		expectedActions []testpkg.Action
+	}{
+		"should approve a certificate request matched by a policy": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca", Kind: v1alpha1.IssuerKind})),
+			},
+			expectedActions: []testpkg.Action{approveAction},
+		},
+		"should approve using the first matching policy by name": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("z-policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"})),
+				gen.ApprovalPolicy("policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"})),
+			},
+			expectedActions: []testpkg.Action{approveAction},
+		},
+		"should approve a certificate request in a namespace and with labels matched by a policy": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy",
+					gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"}),
+					gen.SetApprovalPolicyNamespaces("other", gen.DefaultTestNamespace),
+					gen.SetApprovalPolicySelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}),
+				),
+			},
+			expectedActions: []testpkg.Action{approveAction},
+		},
+		"should not approve a certificate request for a different issuer": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "other"})),
+			},
+		},
+		"should not approve a certificate request for a cluster issuer of the same name": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca", Kind: v1alpha1.ClusterIssuerKind})),
+			},
+		},
+		"should not approve a certificate request in a namespace not matched by the policy": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy",
+					gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"}),
+					gen.SetApprovalPolicyNamespaces("other"),
+				),
+			},
+		},
+		"should not approve a certificate request with labels not matched by the policy": {
+			cr: exampleCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy",
+					gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"}),
+					gen.SetApprovalPolicySelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}}),
+				),
+			},
+		},
+		"should not modify a denied certificate request": {
+			cr: exampleDeniedCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"})),
+			},
+		},
+		"should not modify an approved certificate request": {
+			cr: exampleApprovedCR,
+			policies: []runtime.Object{
+				gen.ApprovalPolicy("policy", gen.SetApprovalPolicyIssuer(v1alpha1.ObjectReference{Name: "ca"})),
+			},
+		},
+	}
+
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			b := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: append([]runtime.Object{test.cr}, test.policies...),
+				ExpectedActions:    test.expectedActions,
+			}
+			b.Start()
+			defer b.Stop()
+
+			c := &controller{}
+			if _, _, err := c.Register(b.Context); err != nil {
+				t.Fatal(err)
+			}
+			b.Sync()
+
+			if err := c.Sync(context.Background(), test.cr.DeepCopy()); err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+			if err := b.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -19,8 +19,11 @@ package certificaterequests
 import (
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 )
@@ -51,6 +54,67 @@ func (c *Controller) handleGenericIssuer(obj interface{}) {
 	}
 }
 
+// handleNamespace will requeue all CertificateRequests in the given namespace,
+// so that a change to the labels of the namespace is reflected in whether a
+// ClusterIssuer permits them, and whether their approval is trusted.
+func (c *Controller) handleNamespace(obj interface{}) {
+	log := c.log.WithName("handleNamespace")
+
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok {
+		log.Error(nil, "object is not a Namespace resource")
+		return
+	}
+	log = logf.WithResource(log, ns)
+
+	crs, err := c.certificateRequestLister.CertificateRequests(ns.Name).List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing certificate requests")
+		return
+	}
+	for _, cr := range crs {
+		log := logf.WithRelatedResource(log, cr)
+		key, err := keyFunc(cr)
+		if err != nil {
+			log.Error(err, "error computing key for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+// handleApprovalPolicy will requeue all CertificateRequests matched by the
+// given ApprovalPolicy, so that approvals in namespaces that are not
+// validated by the webhook are re-evaluated.
+func (c *Controller) handleApprovalPolicy(obj interface{}) {
+	log := c.log.WithName("handleApprovalPolicy")
+
+	p, ok := obj.(*cmapi.ApprovalPolicy)
+	if !ok {
+		log.Error(nil, "object is not an ApprovalPolicy resource")
+		return
+	}
+	log = logf.WithResource(log, p)
+
+	crs, err := c.certificateRequestLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing certificate requests")
+		return
+	}
+	for _, cr := range crs {
+		if matches, _ := apiutil.ApprovalPolicyMatches(p, cr); !matches {
+			continue
+		}
+		log := logf.WithRelatedResource(log, cr)
+		key, err := keyFunc(cr)
+		if err != nil {
+			log.Error(err, "error computing key for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
 func (c *Controller) certificatesRequestsForGenericIssuer(iss cmapi.GenericIssuer) ([]*cmapi.CertificateRequest, error) {
 	crts, err := c.certificateRequestLister.List(labels.NewSelector())
 
@@ -78,3 +142,40 @@ func (c *Controller) certificatesRequestsForGenericIssuer(iss cmapi.GenericIssue
 
 	return affected, nil
 }
+
+// approvalTrusted returns true if the approval of the given CertificateRequest
+// can be trusted. Approvals are only checked by the webhook, which does not
+// validate resources in namespaces labelled with
+// 'certmanager.k8s.io/disable-validation: "true"'. In these namespaces the
+// approval is only trusted if an ApprovalPolicy matches the
+// CertificateRequest.
+// Namespaces are only watched when the controller is not scoped to a single
+// namespace, so otherwise all approvals are trusted.
+func (c *Controller) approvalTrusted(cr *cmapi.CertificateRequest) (bool, error) {
+	if c.namespaceLister == nil {
+		return true, nil
+	}
+
+	ns, err := c.namespaceLister.Get(cr.Namespace)
+	if k8sErrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if ns.Labels[cmapi.DisableValidationLabelKey] != "true" {
+		return true, nil
+	}
+
+	policies, err := c.approvalPolicyLister.List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for _, p := range policies {
+		if matches, _ := apiutil.ApprovalPolicyMatches(p, cr); matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -21,10 +21,12 @@ import (
 
 	"github.com/go-logr/logr"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	cmclient "github.com/leki75/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
 	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
@@ -39,6 +41,14 @@ const (
 
 var keyFunc = controllerpkg.KeyFunc
 
+var certificateRequestGvk = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.CertificateRequestKind)
+
+// OwnedInformerFunc returns an informer for a resource type that issuers
+// create and own on behalf of CertificateRequests, such as ACME Orders.
+// Changes to resources returned by the informer will cause the owning
+// CertificateRequest to be resynced.
+type OwnedInformerFunc func(ctx *controllerpkg.Context) cache.SharedIndexInformer
+
 type Controller struct {
 	helper issuer.Helper
 
@@ -46,6 +56,8 @@ type Controller struct {
 	cmClient cmclient.Interface
 
 	certificateRequestLister cmlisters.CertificateRequestLister
+	certificatePolicyLister  cmlisters.CertificatePolicyLister
+	approvalPolicyLister     cmlisters.ApprovalPolicyLister
 
 	queue   workqueue.RateLimitingInterface
 	metrics *metrics.Metrics
@@ -61,12 +73,18 @@ type Controller struct {
 
 	issuerLister        cmlisters.IssuerLister
 	clusterIssuerLister cmlisters.ClusterIssuerLister
+	namespaceLister     corelisters.NamespaceLister
 	issuerFactory       issuer.IssuerFactory
+
+	// informers for resources owned by certificate requests of this
+	// controller's issuer type
+	ownedInformers []OwnedInformerFunc
 }
 
-func New(issuerType string) *Controller {
+func New(issuerType string, ownedInformers ...OwnedInformerFunc) *Controller {
 	return &Controller{
-		issuerType: issuerType,
+		issuerType:     issuerType,
+		ownedInformers: ownedInformers,
 	}
 }
 
@@ -85,12 +103,16 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// obtain references to all the informers used by this controller
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().CertificateRequests()
+	certificatePolicyInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().CertificatePolicies()
+	approvalPolicyInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().ApprovalPolicies()
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		issuerInformer.Informer().HasSynced,
+		certificatePolicyInformer.Informer().HasSynced,
+		approvalPolicyInformer.Informer().HasSynced,
 	}
 
 	// if scoped to a single namespace
@@ -102,13 +124,34 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 		// register handler function for clusterissuer resources
 		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
 		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+
+		// namespaces are watched so that the namespaceSelector of
+		// clusterissuers, and whether approvals in the namespace are
+		// validated by the webhook, can be re-evaluated when their labels
+		// change
+		namespaceInformer := ctx.KubeSharedInformerFactory.Core().V1().Namespaces()
+		c.namespaceLister = namespaceInformer.Lister()
+		namespaceInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleNamespace})
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
 	}
 
 	// set all the references to the listers for used by the Sync function
 	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.certificatePolicyLister = certificatePolicyInformer.Lister()
+	c.approvalPolicyLister = approvalPolicyInformer.Lister()
 
 	// register handler functions
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	approvalPolicyInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleApprovalPolicy})
+
+	// register handler functions for resources owned by certificate requests
+	for _, informerFunc := range c.ownedInformers {
+		informer := informerFunc(ctx)
+		informer.AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: controllerpkg.HandleOwnedResourceNamespacedFunc(c.log, c.queue, certificateRequestGvk, c.certificateRequestGetter),
+		})
+		mustSync = append(mustSync, informer.HasSynced)
+	}
 
 	// instantiate metrics interface with default metrics implementation
 	c.metrics = metrics.Default
@@ -150,3 +193,7 @@ func (c *Controller) ProcessItem(ctx context.Context, key string) error {
 	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
 	return c.Sync(ctx, cr)
 }
+
+func (c *Controller) certificateRequestGetter(namespace, name string) (interface{}, error) {
+	return c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+}
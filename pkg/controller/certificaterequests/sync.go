@@ -19,16 +19,20 @@ package certificaterequests
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
 
 	"github.com/kr/pretty"
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
 	"github.com/leki75/cert-manager/pkg/issuer"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 	"github.com/leki75/cert-manager/pkg/util/pki"
@@ -36,11 +40,15 @@ import (
 
 const (
 	errorCertificatePending = "CertPending"
-	errorCertificateFailed  = "CertFailed"
+	errorCertificateFailed  = v1alpha1.CertificateRequestReasonFailed
 
 	errorIssuerNotFound = "IssuerNotFound"
 	errorIssuerInit     = "IssuerInitError"
 
+	reasonPolicyViolation = "PolicyViolation"
+	reasonNotPermitted    = "NotPermitted"
+	reasonUnknownUsage    = "UnknownUsage"
+
 	successCertificateIssued = "CertIssued"
 )
 
@@ -114,12 +122,67 @@ func (c *Controller) Sync(ctx context.Context, cr *v1alpha1.CertificateRequest)
 		return nil
 	}
 
+	allowed, err := issuer.NamespaceAllowed(c.namespaceLister, issuerObj, crCopy.Namespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		msg := fmt.Sprintf("ClusterIssuer %q does not permit CertificateRequests in namespace %q", issuerObj.GetObjectMeta().Name, crCopy.Namespace)
+		c.recorder.Event(crCopy, corev1.EventTypeWarning, reasonNotPermitted, msg)
+		apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, reasonNotPermitted, msg)
+		return nil
+	}
+
+	if apiutil.CertificateRequestIsDenied(crCopy) {
+		dbg.Info("certificate request has been denied so skipping processing")
+		apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonDenied, "The CertificateRequest was denied by an approver")
+		return nil
+	}
+
+	if !apiutil.CertificateRequestIsApproved(crCopy) {
+		dbg.Info("certificate request has not been approved so skipping processing")
+		apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, errorCertificatePending, "Waiting for the CertificateRequest to be approved")
+		return nil
+	}
+
+	trusted, err := c.approvalTrusted(crCopy)
+	if err != nil {
+		return err
+	}
+	if !trusted {
+		dbg.Info("certificate request approval was not validated by the webhook so skipping processing")
+		apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, errorCertificatePending, fmt.Sprintf("Waiting for an ApprovalPolicy to approve the CertificateRequest, "+
+				"as approvals in namespace %q are not validated by the webhook", crCopy.Namespace))
+		return nil
+	}
+
+	policies, err := c.certificatePolicyLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	// the spec of a CertificateRequest is immutable, so a request that
+	// violates a policy is marked as failed rather than being retried
+	el = validation.ValidateCertificateRequestForPolicies(crCopy, policies)
+	if len(el) > 0 {
+		msg := fmt.Sprintf("CertificateRequest does not satisfy CertificatePolicy: %v", el.ToAggregate())
+		c.recorder.Event(crCopy, corev1.EventTypeWarning, reasonPolicyViolation, msg)
+		apiutil.SetCertificateRequestCondition(crCopy, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, errorCertificateFailed, msg)
+		return nil
+	}
+
 	i, err := c.issuerFactory.IssuerFor(issuerObj)
 	if err != nil {
 		c.recorder.Eventf(crCopy, corev1.EventTypeWarning, errorIssuerInit, "Internal error initialising issuer: %v", err)
 		return nil
 	}
 
+	c.warnUnknownExtKeyUsages(crCopy)
+
 	// TODO: Metrics??
 
 	dbg.Info("invoking sign function as existing certificate does not exist")
@@ -155,9 +218,40 @@ func (c *Controller) sign(ctx context.Context, cr *v1alpha1.CertificateRequest,
 	return nil
 }
 
+// warnUnknownExtKeyUsages records an event if the CSR of the given
+// CertificateRequest requests extended key usages that are not recognised.
+// These are skipped rather than failing the request.
+func (c *Controller) warnUnknownExtKeyUsages(cr *v1alpha1.CertificateRequest) {
+	if len(cr.Spec.Usages) > 0 {
+		return
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		return
+	}
+
+	unknown, err := pki.UnknownExtKeyUsagesForCSR(csr)
+	if err != nil || len(unknown) == 0 {
+		return
+	}
+
+	c.recorder.Eventf(cr, corev1.EventTypeWarning, reasonUnknownUsage,
+		"Ignoring unrecognised extended key usages requested in the CSR: %v", unknown)
+}
+
 // setCertificateRequestStatus will update the status subresource of the
 // certificate request.
 func (c *Controller) setCertificateRequestStatus(cr *v1alpha1.CertificateRequest) {
+	// The issuer has marked the request as failed
+	if apiutil.CertificateRequestHasCondition(cr, v1alpha1.CertificateRequestCondition{
+		Type:   v1alpha1.CertificateRequestConditionReady,
+		Status: v1alpha1.ConditionFalse,
+		Reason: errorCertificateFailed,
+	}) {
+		return
+	}
+
 	// No cert exists yet
 	if len(cr.Status.Certificate) == 0 {
 		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady,
@@ -188,8 +282,22 @@ func (c *Controller) updateCertificateRequestStatus(ctx context.Context, old, ne
 	}
 
 	log.V(logf.DebugLevel).Info("updating resource due to change in status", "diff", pretty.Diff(string(oldBytes), string(newBytes)))
-	// TODO: replace Update call with UpdateStatus. This requires a custom API
-	// server with the /status subresource enabled and/or subresource support
-	// for CRDs (https://github.com/kubernetes/kubernetes/issues/38113)
-	return c.cmClient.CertmanagerV1alpha1().CertificateRequests(new.Namespace).Update(new)
+	var updated *v1alpha1.CertificateRequest
+	err := controllerpkg.RetryStatusUpdate(func() (err error) {
+		updated, err = c.cmClient.CertmanagerV1alpha1().CertificateRequests(new.Namespace).UpdateStatus(new)
+		return err
+	}, func() (bool, error) {
+		latest, err := c.cmClient.CertmanagerV1alpha1().CertificateRequests(new.Namespace).Get(new.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(latest.Status, old.Status) {
+			return false, nil
+		}
+		status := new.Status
+		new = latest.DeepCopy()
+		new.Status = status
+		return true, nil
+	})
+	return updated, err
 }
@@ -32,6 +32,7 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
@@ -135,6 +136,11 @@ func TestSync(t *testing.T) {
 
 	exampleCR := gen.CertificateRequest("test",
 		gen.SetCertificateRequestIsCA(false),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:   cmapi.CertificateRequestConditionApproved,
+			Status: cmapi.ConditionTrue,
+			Reason: "Approved",
+		}),
 		gen.SetCertificateRequestIssuer(cmapi.ObjectReference{Name: "test"}),
 		gen.SetCertificateRequestCSR(csr),
 		gen.SetCertificateRequestIssuer(cmapi.ObjectReference{
@@ -142,6 +148,12 @@ func TestSync(t *testing.T) {
 			Name: "fake-issuer",
 		}),
 	)
+	exampleCRWithClusterIssuer := gen.CertificateRequestFrom(exampleCR,
+		gen.SetCertificateRequestIssuer(cmapi.ObjectReference{
+			Kind: cmapi.ClusterIssuerKind,
+			Name: "test",
+		}),
+	)
 	exampleCRPendingCondition := gen.CertificateRequestFrom(exampleCR,
 		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
 			Type:               cmapi.CertificateRequestConditionReady,
@@ -198,6 +210,45 @@ func TestSync(t *testing.T) {
 	exampleEmptyCSRCR := exampleCR.DeepCopy()
 	exampleEmptyCSRCR.Spec.CSRPEM = make([]byte, 0)
 
+	exampleUnapprovedCR := exampleCR.DeepCopy()
+	exampleUnapprovedCR.Status.Conditions = nil
+	exampleUnapprovedCRPendingCondition := gen.CertificateRequestFrom(exampleUnapprovedCR,
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             "CertPending",
+			Message:            "Waiting for the CertificateRequest to be approved",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+
+	exampleDeniedCR := gen.CertificateRequestFrom(exampleUnapprovedCR,
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:   cmapi.CertificateRequestConditionDenied,
+			Status: cmapi.ConditionTrue,
+			Reason: "Denied",
+		}),
+	)
+	exampleDeniedCRDeniedCondition := gen.CertificateRequestFrom(exampleDeniedCR,
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmapi.ConditionFalse,
+			Reason:             "Denied",
+			Message:            "The CertificateRequest was denied by an approver",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+
+	exampleNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: gen.DefaultTestNamespace},
+	}
+	unvalidatedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   gen.DefaultTestNamespace,
+			Labels: map[string]string{cmapi.DisableValidationLabelKey: "true"},
+		},
+	}
+
 	tests := map[string]controllerFixture{
 		"should update certificate request with CertPending if issuer does not return a response": {
 			Issuer: gen.Issuer("test",
@@ -219,8 +270,9 @@ func TestSync(t *testing.T) {
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCRPendingCondition,
 					)),
@@ -230,6 +282,224 @@ func TestSync(t *testing.T) {
 			},
 			Err: false,
 		},
+		"should not sign a certificate request that has not been approved": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			CertificateRequest: *exampleUnapprovedCR,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						exampleUnapprovedCRPendingCondition,
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+			},
+			Err: false,
+		},
+		"should mark a denied certificate request as not ready without signing it": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			CertificateRequest: *exampleDeniedCR,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						exampleDeniedCRDeniedCondition,
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+			},
+			Err: false,
+		},
+		"should fail a certificate request that violates a CertificatePolicy without signing it": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			CertificateRequest: *exampleCR,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateRequest("test"),
+					&cmapi.CertificatePolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+						Spec: cmapi.CertificatePolicySpec{
+							AllowedIPRanges: []string{"10.0.0.0/8"},
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(exampleCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             errorCertificateFailed,
+								Message:            `CertificateRequest does not satisfy CertificatePolicy: spec.csr: Forbidden: IP address "8.8.8.8" is not permitted by CertificatePolicy "restricted"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+			},
+			Err: false,
+		},
+		"should mark a certificate request as not ready if the clusterissuer does not permit its namespace": {
+			Issuer: gen.ClusterIssuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+				gen.SetIssuerNamespaceSelector(cmapi.IssuerNamespaceSelector{
+					MatchNames: []string{"payments"},
+				}),
+			),
+			CertificateRequest: *exampleCRWithClusterIssuer,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(exampleCRWithClusterIssuer,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "NotPermitted",
+								Message:            `ClusterIssuer "test" does not permit CertificateRequests in namespace "default-unit-test-ns"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+			},
+			Err: false,
+		},
+		"should not sign an approved certificate request in a namespace not validated by the webhook": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			CertificateRequest: *exampleCR,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{unvalidatedNamespace},
+				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(exampleCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "CertPending",
+								Message:            `Waiting for an ApprovalPolicy to approve the CertificateRequest, as approvals in namespace "default-unit-test-ns" are not validated by the webhook`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+			},
+			Err: false,
+		},
+		"should sign a certificate request in a namespace not validated by the webhook if an ApprovalPolicy matches": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			CertificateRequest: *exampleCR,
+			IssuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certPEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{unvalidatedNamespace},
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateRequest("test"),
+					gen.ApprovalPolicy("fake-issuer",
+						gen.SetApprovalPolicyIssuer(cmapi.ObjectReference{Name: "fake-issuer"}),
+					),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						exampleCRReadyCondition,
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+			},
+			Err: false,
+		},
 		"should update the status with a freshly signed certificate only when one doesn't exist": {
 			Issuer: gen.Issuer("test",
 				gen.AddIssuerCondition(cmapi.IssuerCondition{
@@ -249,8 +519,9 @@ func TestSync(t *testing.T) {
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCRReadyCondition,
 					)),
@@ -299,8 +570,9 @@ func TestSync(t *testing.T) {
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{gen.CertificateRequest("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCRGarbageCondition,
 					)),
@@ -420,6 +692,9 @@ func TestSync(t *testing.T) {
 			if test.Builder == nil {
 				test.Builder = &testpkg.Builder{}
 			}
+			if test.Builder.KubeObjects == nil {
+				test.Builder.KubeObjects = []runtime.Object{exampleNamespace}
+			}
 			test.Clock = fixedClock
 			test.Setup(t)
 			crCopy := test.CertificateRequest.DeepCopy()
@@ -0,0 +1,530 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util"
+	"github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/pkg/util/kube"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+const (
+	errorCertificateRequestFailed = "CertificateRequestFailed"
+	errorCertificateRequestOwner  = "CertificateRequestOwnerError"
+
+	reasonPrivateKeyGenerated  = "Generated"
+	reasonRequested            = "Requested"
+	reasonCertificateRequestGC = "Cleanup"
+
+	// certificateRequestFailedReason is the reason set on the Ready condition
+	// of CertificateRequests that have permanently failed.
+	certificateRequestFailedReason = v1alpha1.CertificateRequestReasonFailed
+)
+
+// issueWithCertificateRequest issues a certificate by creating a
+// CertificateRequest resource owned by the Certificate for its next revision.
+// Once the CertificateRequest has been signed, the resulting certificate is
+// stored in the target secret and the revision recorded in the Certificate's
+// status.
+func (c *controller) issueWithCertificateRequest(ctx context.Context, issuerObj v1alpha1.GenericIssuer, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	key, err := kube.SecretTLSKeyForCertificate(ctx, c.secretLister, crt)
+	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
+		// If we need to generate a new private key, we return once it has
+		// been stored to ensure it is persisted before creating any CSRs
+		// with it. If a valid certificate is already stored, the key is
+		// stored as the next private key so that the certificate continues
+		// to be served until one has been issued for the new key.
+		dbg.Info("generating new private key for certificate")
+		key, err = pki.GeneratePrivateKeyForCertificate(crt)
+		if err != nil {
+			return err
+		}
+		keyPEM, err := pki.EncodePrivateKey(key, crt.Spec.KeyEncoding)
+		if err != nil {
+			return err
+		}
+		if _, err := c.updateSecret(ctx, crt, crt.Namespace, nil, keyPEM, nil); err != nil {
+			log.Error(err, "error saving private key")
+			c.recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+			return err
+		}
+		c.recorder.Event(crt, corev1.EventTypeNormal, reasonPrivateKeyGenerated, "Generated new private key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		return err
+	}
+
+	// the revision of the stored certificate is recorded on the secret in the
+	// same update as the certificate itself, so it is preferred over the
+	// status which may not have been persisted
+	currentRevision := certificateRevision(crt, secret)
+	nextRevision := 1
+	if currentRevision != nil {
+		nextRevision = *currentRevision + 1
+	}
+	crt.Status.Revision = currentRevision
+
+	name, err := certificateRequestName(crt, key, nextRevision)
+	if err != nil {
+		return err
+	}
+	log = log.WithValues(
+		logf.RelatedResourceNameKey, name,
+		logf.RelatedResourceNamespaceKey, crt.Namespace,
+		logf.RelatedResourceKindKey, v1alpha1.CertificateRequestKind,
+	)
+	dbg = log.V(logf.DebugLevel)
+
+	if err := c.deleteStaleCertificateRequests(ctx, crt, name, nextRevision); err != nil {
+		return err
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(crt.Namespace).Get(name)
+	if k8sErrors.IsNotFound(err) {
+		dbg.Info("creating CertificateRequest for next revision of certificate")
+		return c.createCertificateRequest(ctx, issuerObj, crt, key, name, nextRevision)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(cr, crt) {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, errorCertificateRequestOwner, "CertificateRequest %q already exists and is not owned by this Certificate", name)
+		return nil
+	}
+
+	if matches, errs := certificateRequestMatchesSpec(cr, issuerObj, crt, key); !matches {
+		dbg.Info("deleting CertificateRequest as it does not match the certificate spec", "diff", errs)
+		err := c.cmClient.CertmanagerV1alpha1().CertificateRequests(cr.Namespace).Delete(cr.Name, nil)
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	// a denied CertificateRequest will never be signed, so is treated in the
+	// same way as one that has failed
+	if apiutil.CertificateRequestIsDenied(cr) || apiutil.CertificateRequestHasCondition(cr, v1alpha1.CertificateRequestCondition{
+		Type:   v1alpha1.CertificateRequestConditionReady,
+		Status: v1alpha1.ConditionFalse,
+		Reason: certificateRequestFailedReason,
+	}) {
+		// If the failure has not yet been recorded, record it so that the
+		// next attempt is backed off. Otherwise the back-off has elapsed,
+		// and the failed CertificateRequest is deleted so that it will be
+		// re-created on the next sync.
+		failedTime := certificateRequestReadyTransitionTime(cr)
+		lastFailure := crt.Status.LastIssuanceFailureTime
+		if lastFailure == nil || (failedTime != nil && lastFailure.Before(failedTime)) {
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, errorCertificateRequestFailed, "CertificateRequest %q failed: %s", cr.Name, certificateRequestReadyMessage(cr))
+			c.recordIssuanceFailure(crt, certificateRequestReadyMessage(cr))
+			c.scheduleIssuanceRetry(ctx, crt, c.issuanceBackoff(crt))
+			return nil
+		}
+
+		dbg.Info("deleting failed CertificateRequest to retry issuance")
+		err := c.cmClient.CertmanagerV1alpha1().CertificateRequests(cr.Namespace).Delete(cr.Name, nil)
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if len(cr.Status.Certificate) == 0 || !apiutil.CertificateRequestHasCondition(cr, v1alpha1.CertificateRequestCondition{
+		Type:   v1alpha1.CertificateRequestConditionReady,
+		Status: v1alpha1.ConditionTrue,
+	}) {
+		dbg.Info("waiting for CertificateRequest to be ready")
+		return nil
+	}
+
+	// the CertificateRequest was created using the next private key if one
+	// has been generated, so it is stored alongside the new certificate
+	keyPEM := secret.Data[corev1.TLSPrivateKeyKey]
+	if nextKeyPEM := secret.Data[v1alpha1.NextPrivateKeySecretKey]; privateKeyMatchesCertificate(nextKeyPEM, cr.Status.Certificate) {
+		keyPEM = nextKeyPEM
+	}
+
+	// the revision is set before the secret is updated so that it is
+	// recorded on the secret, and is only kept if the update succeeds
+	crt.Status.Revision = &nextRevision
+	if _, err := c.updateSecret(ctx, crt, crt.Namespace, cr.Status.Certificate, keyPEM, cr.Status.CA); err != nil {
+		crt.Status.Revision = currentRevision
+		log.Error(err, "error saving certificate")
+		c.recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+		return err
+	}
+
+	c.recorder.Event(crt, corev1.EventTypeNormal, successCertificateIssued, "Certificate issued successfully")
+	// any manually requested renewal has now been completed
+	apiutil.RemoveCertificateCondition(crt, v1alpha1.CertificateConditionRenewalRequested)
+	resetIssuanceFailures(crt)
+	// as we have just written a certificate, we should schedule it for renewal
+	c.scheduleRenewal(ctx, crt)
+
+	return c.cleanupCertificateRequests(ctx, crt)
+}
+
+// createCertificateRequest creates a CertificateRequest for the given
+// revision of the Certificate, containing a CSR signed by the given key.
+func (c *controller) createCertificateRequest(ctx context.Context, issuerObj v1alpha1.GenericIssuer, crt *v1alpha1.Certificate, key crypto.Signer, name string, revision int) error {
+	template, err := pki.GenerateCSR(issuerObj, crt)
+	if err != nil {
+		return err
+	}
+	derBytes, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		return err
+	}
+
+	// copy across labels from the Certificate resource onto the
+	// CertificateRequest, so that they can be used by issuers (e.g. to
+	// select ACME challenge solvers)
+	lbls := make(map[string]string, len(crt.Labels)+1)
+	for k, v := range crt.Labels {
+		lbls[k] = v
+	}
+	lbls[v1alpha1.CertificateNameKey] = crt.Name
+
+	annotations := map[string]string{
+		v1alpha1.CertificateRequestRevisionAnnotationKey: strconv.Itoa(revision),
+	}
+	// the SelfSigned issuer signs the request using the Certificate's own
+	// private key, which is stored in the target secret
+	if issuerObj.GetSpec().SelfSigned != nil {
+		annotations[v1alpha1.CRPrivateKeyAnnotationKey] = crt.Spec.SecretName
+	}
+
+	cr := &v1alpha1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       crt.Namespace,
+			Labels:          lbls,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(crt)},
+		},
+		Spec: v1alpha1.CertificateRequestSpec{
+			Duration:  crt.Spec.Duration,
+			IssuerRef: crt.Spec.IssuerRef,
+			CSRPEM:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes}),
+			IsCA:      crt.Spec.IsCA,
+			Usages:    crt.Spec.Usages,
+		},
+	}
+
+	_, err = c.cmClient.CertmanagerV1alpha1().CertificateRequests(crt.Namespace).Create(cr)
+	if err != nil {
+		// the CertificateRequest may not have been observed by the lister yet
+		if k8sErrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRequested, "Created new CertificateRequest resource %q", name)
+	return nil
+}
+
+// privateKeyMatchesCertificate returns true if the given PEM encoded private
+// key is the private key of the given PEM encoded certificate.
+func privateKeyMatchesCertificate(keyPEM, certPEM []byte) bool {
+	if len(keyPEM) == 0 {
+		return false
+	}
+	key, err := pki.DecodePrivateKeyBytes(keyPEM)
+	if err != nil {
+		return false
+	}
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return false
+	}
+	matches, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+	return err == nil && matches
+}
+
+// certificateRequestMatchesSpec checks that the given CertificateRequest was
+// created for the current spec of the Certificate, and that its CSR was
+// signed by the private key currently stored for the Certificate.
+func certificateRequestMatchesSpec(cr *v1alpha1.CertificateRequest, issuerObj v1alpha1.GenericIssuer, crt *v1alpha1.Certificate, key crypto.Signer) (bool, []string) {
+	var errs []string
+
+	// the defaults may have been persisted on either resource by the webhook,
+	// so the resources are compared with their defaults applied
+	cr, crt = cr.DeepCopy(), crt.DeepCopy()
+	v1alpha1.SetObjectDefaults_CertificateRequest(cr)
+	v1alpha1.SetObjectDefaults_Certificate(crt)
+
+	if !reflect.DeepEqual(cr.Spec.IssuerRef, crt.Spec.IssuerRef) {
+		errs = append(errs, fmt.Sprintf("Issuer of the CertificateRequest is not up to date: %q", cr.Spec.IssuerRef.Name))
+	}
+	// Certificates created before the duration was defaulted may not have it
+	// set, so the duration it resolves to is compared instead
+	if cr.Spec.Duration == nil || cr.Spec.Duration.Duration != certificateDuration(crt) {
+		errs = append(errs, fmt.Sprintf("Duration of the CertificateRequest is not up to date: %v", cr.Spec.Duration))
+	}
+	if cr.Spec.IsCA != crt.Spec.IsCA {
+		errs = append(errs, fmt.Sprintf("CA flag of the CertificateRequest is not up to date: %t", cr.Spec.IsCA))
+	}
+	if !reflect.DeepEqual(cr.Spec.Usages, crt.Spec.Usages) {
+		errs = append(errs, fmt.Sprintf("Usages of the CertificateRequest are not up to date: %v", cr.Spec.Usages))
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		return false, append(errs, err.Error())
+	}
+
+	expected, err := pki.GenerateCSR(issuerObj, crt)
+	if err != nil {
+		return false, append(errs, err.Error())
+	}
+
+	matches, err := pki.PublicKeyMatchesCSR(key.Public(), csr)
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else if !matches {
+		errs = append(errs, "CSR of the CertificateRequest was not signed by the certificate's private key")
+	}
+
+	if csr.Subject.String() != expected.Subject.String() {
+		errs = append(errs, fmt.Sprintf("Subject of the CSR is not up to date: %q", csr.Subject.String()))
+	}
+	if !util.EqualUnsorted(csr.DNSNames, expected.DNSNames) {
+		errs = append(errs, fmt.Sprintf("DNS names of the CSR are not up to date: %q", csr.DNSNames))
+	}
+	if !util.EqualUnsorted(pki.IPAddressesToString(csr.IPAddresses), pki.IPAddressesToString(expected.IPAddresses)) {
+		errs = append(errs, fmt.Sprintf("IP addresses of the CSR are not up to date: %q", pki.IPAddressesToString(csr.IPAddresses)))
+	}
+	if !util.EqualUnsorted(pki.URLsToString(csr.URIs), pki.URLsToString(expected.URIs)) {
+		errs = append(errs, fmt.Sprintf("URI SANs of the CSR are not up to date: %q", pki.URLsToString(csr.URIs)))
+	}
+	if !util.EqualUnsorted(csr.EmailAddresses, expected.EmailAddresses) {
+		errs = append(errs, fmt.Sprintf("Email SANs of the CSR are not up to date: %q", csr.EmailAddresses))
+	}
+
+	return len(errs) == 0, errs
+}
+
+// cleanupCertificateRequests deletes the oldest CertificateRequests owned by
+// the Certificate until no more than the Certificate's revisionHistoryLimit
+// remain. If no limit is set, no CertificateRequests are deleted.
+func (c *controller) cleanupCertificateRequests(ctx context.Context, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	if crt.Spec.RevisionHistoryLimit == nil {
+		return nil
+	}
+
+	req, err := labels.NewRequirement(v1alpha1.CertificateNameKey, selection.Equals, []string{crt.Name})
+	if err != nil {
+		return err
+	}
+	selector := labels.NewSelector().Add(*req)
+
+	existing, err := c.certificateRequestLister.CertificateRequests(crt.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+
+	var revisions []revisionedCertificateRequest
+	for _, cr := range existing {
+		// Don't touch any objects that don't have this certificate set as the
+		// owner reference.
+		if !metav1.IsControlledBy(cr, crt) {
+			continue
+		}
+		revision, err := strconv.Atoi(cr.Annotations[v1alpha1.CertificateRequestRevisionAnnotationKey])
+		if err != nil {
+			log.V(logf.DebugLevel).Info("skipping CertificateRequest with invalid revision annotation", "name", cr.Name)
+			continue
+		}
+		revisions = append(revisions, revisionedCertificateRequest{revision: revision, req: cr})
+	}
+
+	limit := int(*crt.Spec.RevisionHistoryLimit)
+	if len(revisions) <= limit {
+		return nil
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].revision < revisions[j].revision
+	})
+
+	var errs []error
+	for _, r := range revisions[:len(revisions)-limit] {
+		log := logf.WithRelatedResource(log, r.req)
+		log.Info("Deleting CertificateRequest resource")
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonCertificateRequestGC, "Deleting old CertificateRequest resource %q", r.req.Name)
+
+		err := c.cmClient.CertmanagerV1alpha1().CertificateRequests(r.req.Namespace).Delete(r.req.Name, nil)
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			log.Error(err, "error deleting CertificateRequest resource")
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+type revisionedCertificateRequest struct {
+	revision int
+	req      *v1alpha1.CertificateRequest
+}
+
+// deleteStaleCertificateRequests deletes any CertificateRequests owned by the
+// Certificate for the given revision that were created for a previous spec or
+// private key, and so have a name other than the given one.
+func (c *controller) deleteStaleCertificateRequests(ctx context.Context, crt *v1alpha1.Certificate, name string, revision int) error {
+	log := logf.FromContext(ctx)
+
+	req, err := labels.NewRequirement(v1alpha1.CertificateNameKey, selection.Equals, []string{crt.Name})
+	if err != nil {
+		return err
+	}
+	selector := labels.NewSelector().Add(*req)
+
+	existing, err := c.certificateRequestLister.CertificateRequests(crt.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, cr := range existing {
+		if cr.Name == name || !metav1.IsControlledBy(cr, crt) {
+			continue
+		}
+		if cr.Annotations[v1alpha1.CertificateRequestRevisionAnnotationKey] != strconv.Itoa(revision) {
+			continue
+		}
+
+		log.V(logf.DebugLevel).Info("deleting CertificateRequest as it does not match the certificate spec", "name", cr.Name)
+		err := c.cmClient.CertmanagerV1alpha1().CertificateRequests(cr.Namespace).Delete(cr.Name, nil)
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// certificateRevision returns the revision of the certificate stored in the
+// given secret. The revision recorded on the secret is used if it is ahead of
+// the revision in the Certificate's status.
+func certificateRevision(crt *v1alpha1.Certificate, secret *corev1.Secret) *int {
+	revision := crt.Status.Revision
+	stored, err := strconv.Atoi(secret.Annotations[v1alpha1.CertificateRequestRevisionAnnotationKey])
+	if err == nil && (revision == nil || stored > *revision) {
+		revision = &stored
+	}
+	return revision
+}
+
+// certificateRequestName returns the name of the CertificateRequest created
+// for the given revision of the Certificate. The name is derived from a hash
+// of the Certificate's spec, the public key of the private key used to sign
+// the CSR and the revision, so that concurrent or retried syncs for the same
+// revision always agree on the CertificateRequest to use.
+func certificateRequestName(crt *v1alpha1.Certificate, key crypto.Signer, revision int) (string, error) {
+	hash, err := hashCertificateRequest(crt, key, revision)
+	if err != nil {
+		return "", err
+	}
+
+	// truncate certificate name so final name will be <= 63 characters.
+	// hash (uint32) will be at most 10 digits long, and we account for
+	// the hyphen.
+	return fmt.Sprintf("%.52s-%d", crt.Name, hash), nil
+}
+
+func hashCertificateRequest(crt *v1alpha1.Certificate, key crypto.Signer, revision int) (uint32, error) {
+	// the defaults may have been persisted on the Certificate by the webhook,
+	// so the spec is hashed with its defaults applied
+	crt = crt.DeepCopy()
+	v1alpha1.SetObjectDefaults_Certificate(crt)
+
+	specBytes, err := json.Marshal(crt.Spec)
+	if err != nil {
+		return 0, err
+	}
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return 0, err
+	}
+
+	hashF := fnv.New32()
+	for _, b := range [][]byte{specBytes, publicKeyBytes, []byte(strconv.Itoa(revision))} {
+		if _, err := hashF.Write(b); err != nil {
+			return 0, err
+		}
+	}
+
+	return hashF.Sum32(), nil
+}
+
+// certificateRequestReadyMessage returns the message of the Ready condition
+// on the given CertificateRequest.
+func certificateRequestReadyMessage(cr *v1alpha1.CertificateRequest) string {
+	for _, cond := range cr.Status.Conditions {
+		if cond.Type == v1alpha1.CertificateRequestConditionReady {
+			return cond.Message
+		}
+	}
+	return ""
+}
+
+// certificateRequestReadyTransitionTime returns the last transition time of
+// the Ready condition on the given CertificateRequest.
+func certificateRequestReadyTransitionTime(cr *v1alpha1.CertificateRequest) *metav1.Time {
+	for _, cond := range cr.Status.Conditions {
+		if cond.Type == v1alpha1.CertificateRequestConditionReady {
+			return cond.LastTransitionTime
+		}
+	}
+	return nil
+}
@@ -0,0 +1,511 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	coretesting "k8s.io/client-go/testing"
+	clock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/feature"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func generateCSR(t *testing.T, crt *cmapi.Certificate, key crypto.Signer) []byte {
+	template, err := pki.GenerateCSR(nil, crt)
+	if err != nil {
+		t.Errorf("failed to generate CSR template: %v", err)
+		t.FailNow()
+	}
+	derBytes, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		t.Errorf("failed to encode CSR: %v", err)
+		t.FailNow()
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes})
+}
+
+func TestIssueWithCertificateRequest(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.SetFromMap(map[string]bool{string(feature.CertificateRequestControllers): true}); err != nil {
+		t.Fatalf("error enabling feature gate: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.SetFromMap(map[string]bool{string(feature.CertificateRequestControllers): false})
+
+	nowTime := time.Now()
+	nowMetaTime := metav1.NewTime(nowTime)
+	fixedClock := clock.NewFakeClock(nowTime)
+
+	readyIssuer := gen.Issuer("test",
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmapi.ConditionTrue,
+		}),
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+	)
+	exampleCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateRevision(1),
+	)
+	temporaryCondition := gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionReady,
+		Status:             cmapi.ConditionFalse,
+		Reason:             "TemporaryCertificate",
+		Message:            "Certificate issuance in progress. Temporary certificate issued.",
+		LastTransitionTime: &nowMetaTime,
+	})
+
+	pk1 := generatePrivateKey(t)
+	pk1PEM := pki.EncodePKCS1PrivateKey(pk1)
+	cert1PEM := generateSelfSignedCert(t, exampleCert, nil, pk1, nowTime, nowTime.Add(time.Hour*12))
+	localTempCert := generateSelfSignedCert(t, exampleCert, big.NewInt(pki.TemporaryCertificateSerialNumber), pk1, nowTime, nowTime)
+	pk2 := generatePrivateKey(t)
+
+	requestName := func(crt *cmapi.Certificate, key crypto.Signer, revision int) string {
+		name, err := certificateRequestName(crt, key, revision)
+		if err != nil {
+			t.Fatalf("failed to generate CertificateRequest name: %v", err)
+		}
+		return name
+	}
+	limitedCert := gen.CertificateFrom(exampleCert, gen.SetCertificateRevisionHistoryLimit(1))
+
+	temporarySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "output",
+			SelfLink:  "abc",
+			Labels: map[string]string{
+				cmapi.CertificateNameKey: "test",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       localTempCert,
+			corev1.TLSPrivateKeyKey: pk1PEM,
+		},
+	}
+
+	revisionedSecret := temporarySecret.DeepCopy()
+	revisionedSecret.Annotations = map[string]string{
+		cmapi.CertificateRequestRevisionAnnotationKey: "2",
+	}
+
+	ownedRequest := func(name string, revision int, key crypto.Signer, mods ...gen.CertificateRequestModifier) *cmapi.CertificateRequest {
+		return gen.CertificateRequest(name, append([]gen.CertificateRequestModifier{
+			gen.SetCertificateRequestIssuer(exampleCert.Spec.IssuerRef),
+			gen.SetCertificateRequestCSR(generateCSR(t, exampleCert, key)),
+			gen.SetCertificateRequestLabels(map[string]string{cmapi.CertificateNameKey: "test"}),
+			gen.SetCertificateRequestAnnotations(map[string]string{
+				cmapi.CertificateRequestRevisionAnnotationKey: fmt.Sprintf("%d", revision),
+			}),
+			gen.SetCertificateRequestOwnerReference(ownerRef(exampleCert)),
+		}, mods...)...)
+	}
+	readyCondition := gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+		Type:   cmapi.CertificateRequestConditionReady,
+		Status: cmapi.ConditionTrue,
+		Reason: "Ready",
+	})
+	failedCondition := gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionReady,
+		Status:             cmapi.ConditionFalse,
+		Reason:             "CertFailed",
+		Message:            "issuer unavailable",
+		LastTransitionTime: &nowMetaTime,
+	})
+	deniedCondition := gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+		Type:   cmapi.CertificateRequestConditionDenied,
+		Status: cmapi.ConditionTrue,
+		Reason: "Denied",
+	})
+	afterBackoffTime := metav1.NewTime(nowTime.Add(time.Hour * 2))
+
+	tests := map[string]controllerFixture{
+		"should create a CertificateRequest for the next revision of the certificate": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert, temporaryCondition),
+					)),
+					testpkg.NewCustomMatch(coretesting.NewCreateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						gen.CertificateRequest(requestName(exampleCert, pk1, 2)),
+					), func(exp, actual coretesting.Action) error {
+						cr, ok := actual.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+						if !ok {
+							return fmt.Errorf("expected a CertificateRequest to be created")
+						}
+						if expected := requestName(exampleCert, pk1, 2); cr.Name != expected {
+							return fmt.Errorf("expected CertificateRequest name %q, got %q", expected, cr.Name)
+						}
+						if rev := cr.Annotations[cmapi.CertificateRequestRevisionAnnotationKey]; rev != "2" {
+							return fmt.Errorf("expected revision annotation %q, got %q", "2", rev)
+						}
+						if name := cr.Annotations[cmapi.CRPrivateKeyAnnotationKey]; name != "output" {
+							return fmt.Errorf("expected private key annotation %q for SelfSigned issuer, got %q", "output", name)
+						}
+						if !metav1.IsControlledBy(cr, exampleCert) {
+							return fmt.Errorf("expected CertificateRequest to be owned by the Certificate")
+						}
+						csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+						if err != nil {
+							return err
+						}
+						if matches, err := pki.PublicKeyMatchesCSR(pk1.Public(), csr); err != nil || !matches {
+							return fmt.Errorf("expected CSR to be signed by the stored private key")
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"should store the certificate from a ready CertificateRequest and cleanup old revisions": {
+			Issuer:      readyIssuer,
+			Certificate: *limitedCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(limitedCert, pk1, 1), 1, pk1, readyCondition),
+					ownedRequest(requestName(limitedCert, pk1, 2), 2, pk1, readyCondition, gen.SetCertificateRequestCertificate(cert1PEM)),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							temporaryCondition,
+							gen.SetCertificateRevisionHistoryLimit(1),
+							gen.SetCertificateRevision(2),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"certmanager.k8s.io/alt-names":                "example.com",
+									"certmanager.k8s.io/common-name":              "example.com",
+									"certmanager.k8s.io/ip-sans":                  "",
+									"certmanager.k8s.io/uri-sans":                 "",
+									"certmanager.k8s.io/email-sans":               "",
+									"certmanager.k8s.io/issuer-kind":              "Issuer",
+									"certmanager.k8s.io/issuer-name":              "test",
+									"certmanager.k8s.io/duration":                 "2160h0m0s",
+									cmapi.CertificateRequestRevisionAnnotationKey: "2",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						requestName(limitedCert, pk1, 1),
+					)),
+				},
+			},
+		},
+		"should store the certificate from a CertificateRequest that has had defaults applied": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(exampleCert, pk1, 2), 2, pk1, readyCondition,
+						gen.SetCertificateRequestCertificate(cert1PEM),
+						gen.SetCertificateRequestIssuer(cmapi.ObjectReference{Name: "test", Kind: cmapi.IssuerKind}),
+						gen.SetCertificateRequestDuration(&metav1.Duration{Duration: cmapi.DefaultCertificateDuration}),
+					),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							temporaryCondition,
+							gen.SetCertificateRevision(2),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"certmanager.k8s.io/alt-names":                "example.com",
+									"certmanager.k8s.io/common-name":              "example.com",
+									"certmanager.k8s.io/ip-sans":                  "",
+									"certmanager.k8s.io/uri-sans":                 "",
+									"certmanager.k8s.io/email-sans":               "",
+									"certmanager.k8s.io/issuer-kind":              "Issuer",
+									"certmanager.k8s.io/issuer-name":              "test",
+									"certmanager.k8s.io/duration":                 "2160h0m0s",
+									cmapi.CertificateRequestRevisionAnnotationKey: "2",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		"should replace a CertificateRequest that was not signed by the stored private key": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(exampleCert, pk2, 2), 2, pk2),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert, temporaryCondition),
+					)),
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						requestName(exampleCert, pk2, 2),
+					)),
+					testpkg.NewCustomMatch(coretesting.NewCreateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						gen.CertificateRequest(requestName(exampleCert, pk1, 2)),
+					), func(exp, actual coretesting.Action) error {
+						cr, ok := actual.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+						if !ok {
+							return fmt.Errorf("expected a CertificateRequest to be created")
+						}
+						if expected := requestName(exampleCert, pk1, 2); cr.Name != expected {
+							return fmt.Errorf("expected CertificateRequest name %q, got %q", expected, cr.Name)
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"should record a failed CertificateRequest as a failed issuance attempt": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(exampleCert, pk1, 2), 2, pk1, failedCondition),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							temporaryCondition,
+							gen.SetCertificateLastIssuanceFailureTime(nowMetaTime),
+							gen.SetCertificateLastIssuanceFailureGeneration(0),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+				},
+			},
+		},
+		"should record a denied CertificateRequest as a failed issuance attempt": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(exampleCert, pk1, 2), 2, pk1, deniedCondition),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							temporaryCondition,
+							gen.SetCertificateLastIssuanceFailureTime(nowMetaTime),
+							gen.SetCertificateLastIssuanceFailureGeneration(0),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+				},
+			},
+		},
+		"should delete a failed CertificateRequest once the issuance back-off has elapsed": {
+			Issuer: readyIssuer,
+			Certificate: *gen.CertificateFrom(exampleCert,
+				gen.SetCertificateLastIssuanceFailureTime(nowMetaTime),
+				gen.SetCertificateLastIssuanceFailureGeneration(0),
+				gen.SetCertificateFailedIssuanceAttempts(1),
+			),
+			Clock: clock.NewFakeClock(afterBackoffTime.Time),
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(exampleCert, pk1, 2), 2, pk1, failedCondition),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "TemporaryCertificate",
+								Message:            "Certificate issuance in progress. Temporary certificate issued.",
+								LastTransitionTime: &afterBackoffTime,
+							}),
+							gen.SetCertificateLastIssuanceFailureTime(nowMetaTime),
+							gen.SetCertificateLastIssuanceFailureGeneration(0),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						requestName(exampleCert, pk1, 2),
+					)),
+				},
+			},
+		},
+		"should use the revision recorded on the secret if the status was not updated": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{revisionedSecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					// the CertificateRequest for the revision already stored
+					// must not be used again
+					ownedRequest(requestName(exampleCert, pk1, 2), 2, pk1, readyCondition, gen.SetCertificateRequestCertificate(cert1PEM)),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert, temporaryCondition, gen.SetCertificateRevision(2)),
+					)),
+					testpkg.NewCustomMatch(coretesting.NewCreateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						gen.DefaultTestNamespace,
+						gen.CertificateRequest(requestName(exampleCert, pk1, 3)),
+					), func(exp, actual coretesting.Action) error {
+						cr, ok := actual.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+						if !ok {
+							return fmt.Errorf("expected a CertificateRequest to be created")
+						}
+						if rev := cr.Annotations[cmapi.CertificateRequestRevisionAnnotationKey]; rev != "3" {
+							return fmt.Errorf("expected revision annotation %q, got %q", "3", rev)
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"should not store the certificate from a pending CertificateRequest": {
+			Issuer:      readyIssuer,
+			Certificate: *exampleCert,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{temporarySecret},
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					ownedRequest(requestName(exampleCert, pk1, 2), 2, pk1),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert, temporaryCondition),
+					)),
+				},
+			},
+		},
+	}
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			if test.Clock == nil {
+				test.Clock = fixedClock
+			}
+			test.Setup(t)
+			crtCopy := test.Certificate.DeepCopy()
+			err := test.Controller.Sync(test.Ctx, crtCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, crtCopy, err)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -51,6 +52,70 @@ func (c *controller) handleGenericIssuer(obj interface{}) {
 	}
 }
 
+// handleCertificatePolicy will requeue all Certificates that the given
+// CertificatePolicy applies to, so that a change to the policy is reflected
+// in the Ready condition of those Certificates.
+func (c *controller) handleCertificatePolicy(obj interface{}) {
+	log := c.log.WithName("handleCertificatePolicy")
+
+	policy, ok := obj.(*cmapi.CertificatePolicy)
+	if !ok {
+		log.Error(nil, "object is not a CertificatePolicy resource")
+		return
+	}
+	log = logf.WithResource(log, policy)
+
+	crts, err := c.certificateLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing certificates")
+		return
+	}
+	for _, crt := range crts {
+		if !validation.CertificatePolicyApplies(policy, crt.Namespace, crt.Spec.IssuerRef) {
+			continue
+		}
+		log := logf.WithRelatedResource(log, crt)
+		key, err := keyFunc(crt)
+		if err != nil {
+			log.Error(err, "error computing key for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+// handleNamespace will requeue all Certificates in the given namespace that
+// reference a ClusterIssuer, so that a change to the labels of the namespace
+// is reflected in whether the ClusterIssuer permits them.
+func (c *controller) handleNamespace(obj interface{}) {
+	log := c.log.WithName("handleNamespace")
+
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok {
+		log.Error(nil, "object is not a Namespace resource")
+		return
+	}
+	log = logf.WithResource(log, ns)
+
+	crts, err := c.certificateLister.Certificates(ns.Name).List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing certificates")
+		return
+	}
+	for _, crt := range crts {
+		if crt.Spec.IssuerRef.Kind != cmapi.ClusterIssuerKind {
+			continue
+		}
+		log := logf.WithRelatedResource(log, crt)
+		key, err := keyFunc(crt)
+		if err != nil {
+			log.Error(err, "error computing key for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
 func (c *controller) handleSecretResource(obj interface{}) {
 	log := c.log.WithName("handleSecretResource")
 
@@ -91,6 +156,14 @@ func (c *controller) certificatesForSecret(secret *corev1.Secret) ([]*cmapi.Cert
 		}
 		if crt.Spec.SecretName == secret.Name {
 			affected = append(affected, crt)
+			continue
+		}
+		// also observe Secrets containing keystore passwords so that the
+		// keystores can be updated when the password is changed
+		jks, pkcs12 := keystoresForCertificate(crt)
+		if (jks != nil && jks.PasswordSecretRef.Name == secret.Name) ||
+			(pkcs12 != nil && pkcs12.PasswordSecretRef.Name == secret.Name) {
+			affected = append(affected, crt)
 		}
 	}
 
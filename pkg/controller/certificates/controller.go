@@ -50,9 +50,13 @@ type controller struct {
 
 	issuerLister        cmlisters.IssuerLister
 	clusterIssuerLister cmlisters.ClusterIssuerLister
+	namespaceLister     corelisters.NamespaceLister
 	certificateLister   cmlisters.CertificateLister
 	secretLister        corelisters.SecretLister
 
+	certificateRequestLister cmlisters.CertificateRequestLister
+	certificatePolicyLister  cmlisters.CertificatePolicyLister
+
 	scheduledWorkQueue scheduler.ScheduledWorkQueue
 	metrics            *metrics.Metrics
 
@@ -84,6 +88,15 @@ type controller struct {
 	// to the controller context, and to make it easier to fake out this call during tests.
 	calculateDurationUntilRenew func(ctx context.Context, cert *x509.Certificate, crt *v1alpha1.Certificate) time.Duration
 
+	// calculateRenewalTime returns the time at which the controller should
+	// begin attempting to renew the certificate, given the provided existing
+	// certificate and certificate spec.
+	calculateRenewalTime func(ctx context.Context, cert *x509.Certificate, crt *v1alpha1.Certificate) time.Time
+
+	// resourceNamespace returns the namespace that resources referenced by
+	// the given issuer, such as the Secret of a CA issuer, are stored in.
+	resourceNamespace func(iss v1alpha1.GenericIssuer) string
+
 	// if addOwnerReferences is enabled then the controller will add owner references
 	// to the secret resources it creates
 	addOwnerReferences bool
@@ -104,6 +117,8 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().Issuers()
 	secretsInformer := ctx.KubeSharedInformerFactory.Core().V1().Secrets()
 	ordersInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().Orders()
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().CertificateRequests()
+	certificatePolicyInformer := ctx.SharedInformerFactory.Certmanager().V1alpha1().CertificatePolicies()
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -112,12 +127,16 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 		issuerInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		ordersInformer.Informer().HasSynced,
+		certificateRequestInformer.Informer().HasSynced,
+		certificatePolicyInformer.Informer().HasSynced,
 	}
 
 	// set all the references to the listers for used by the Sync function
 	c.certificateLister = certificateInformer.Lister()
 	c.issuerLister = issuerInformer.Lister()
 	c.secretLister = secretsInformer.Lister()
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.certificatePolicyLister = certificatePolicyInformer.Lister()
 
 	// if scoped to a single namespace
 	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
@@ -128,6 +147,13 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 		// register handler function for clusterissuer resources
 		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
 		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+
+		// namespaces are watched so that the namespaceSelector of
+		// clusterissuers can be re-evaluated when their labels change
+		namespaceInformer := ctx.KubeSharedInformerFactory.Core().V1().Namespaces()
+		c.namespaceLister = namespaceInformer.Lister()
+		namespaceInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleNamespace})
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
 	}
 
 	// register handler functions
@@ -137,6 +163,10 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	ordersInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
 		WorkFunc: controllerpkg.HandleOwnedResourceNamespacedFunc(c.log, c.queue, certificateGvk, c.certificateGetter),
 	})
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: controllerpkg.HandleOwnedResourceNamespacedFunc(c.log, c.queue, certificateGvk, c.certificateGetter),
+	})
+	certificatePolicyInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleCertificatePolicy})
 
 	// Create a scheduled work queue that calls the ctrl.queue.Add method for
 	// each object in the queue. This is used to schedule re-checks of
@@ -162,9 +192,11 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	// the localTemporarySigner is used to sign 'temporary certificates' during
 	// asynchronous certificate issuance flows
 	c.localTemporarySigner = generateLocallySignedTemporaryCertificate
-	// use the controller context provided versions of these two methods
+	// use the controller context provided versions of these methods
 	c.certificateNeedsRenew = ctx.IssuerOptions.CertificateNeedsRenew
 	c.calculateDurationUntilRenew = ctx.IssuerOptions.CalculateDurationUntilRenew
+	c.calculateRenewalTime = ctx.IssuerOptions.CalculateRenewalTime
+	c.resourceNamespace = ctx.IssuerOptions.ResourceNamespace
 	c.cmClient = ctx.CMClient
 	c.kClient = ctx.Client
 	c.addOwnerReferences = ctx.CertificateOptions.EnableOwnerRef
@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+// syncRenewalInfo ensures the renewal window suggested by the issuer for the
+// given certificate is recorded in the Certificate's status.
+// Renewal information is only requested again once the recorded information
+// is for a different certificate, or its next check time has passed.
+// It will not actually submit the resource to the apiserver.
+func (c *controller) syncRenewalInfo(ctx context.Context, i issuer.Interface, crt *v1alpha1.Certificate, cert *x509.Certificate) {
+	log := logf.FromContext(ctx, "renewalInfo")
+
+	p, ok := i.(issuer.RenewalInfoProvider)
+	if !ok {
+		crt.Status.RenewalInfo = nil
+		return
+	}
+
+	current := crt.Status.RenewalInfo
+	if current != nil && current.SerialNumber != serialNumber(cert) {
+		current = nil
+	}
+	if current != nil && c.clock.Now().Before(current.NextCheckTime.Time) {
+		return
+	}
+
+	info, err := p.RenewalInfo(ctx, crt, cert)
+	if err == issuer.ErrRenewalInfoNotSupported {
+		crt.Status.RenewalInfo = nil
+		return
+	}
+	if err != nil {
+		// keep any renewal information previously recorded for this
+		// certificate and try again on the next sync.
+		log.Error(err, "error requesting renewal information from issuer")
+		crt.Status.RenewalInfo = current
+		return
+	}
+
+	log.V(logf.DebugLevel).Info("fetched renewal information from issuer",
+		"window_start", info.SuggestedWindowStart, "window_end", info.SuggestedWindowEnd)
+	crt.Status.RenewalInfo = info
+
+	// the renewal time shown in the status takes the suggested window into
+	// account.
+	renewalTime := metav1.NewTime(c.calculateRenewalTime(ctx, cert, crt))
+	crt.Status.RenewalTime = &renewalTime
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	"github.com/leki75/cert-manager/pkg/issuer/fake"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+// noRenewalInfoIssuer is an issuer that does not implement the optional
+// issuer.RenewalInfoProvider interface.
+type noRenewalInfoIssuer struct {
+	issuer.Interface
+}
+
+func TestSyncRenewalInfo(t *testing.T) {
+	nowTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{SerialNumber: big.NewInt(0xbeef)}
+
+	renewalInfo := func(serial string, nextCheck time.Time) *cmapi.CertificateRenewalInfo {
+		return &cmapi.CertificateRenewalInfo{
+			SerialNumber:         serial,
+			SuggestedWindowStart: metav1.NewTime(nowTime.Add(time.Hour)),
+			SuggestedWindowEnd:   metav1.NewTime(nowTime.Add(time.Hour * 2)),
+			NextCheckTime:        metav1.NewTime(nextCheck),
+		}
+	}
+	current := renewalInfo("beef", nowTime.Add(time.Hour))
+	fetched := renewalInfo("beef", nowTime.Add(time.Hour*6))
+
+	tests := map[string]struct {
+		existing    *cmapi.CertificateRenewalInfo
+		info        *cmapi.CertificateRenewalInfo
+		err         error
+		noProvider  bool
+		called      bool
+		expected    *cmapi.CertificateRenewalInfo
+		renewalTime bool
+	}{
+		"should fetch renewal information if none is recorded": {
+			info:        fetched,
+			called:      true,
+			expected:    fetched,
+			renewalTime: true,
+		},
+		"should not fetch renewal information before the next check time": {
+			existing: current,
+			expected: current,
+		},
+		"should fetch renewal information once the next check time has passed": {
+			existing:    renewalInfo("beef", nowTime.Add(-time.Minute)),
+			info:        fetched,
+			called:      true,
+			expected:    fetched,
+			renewalTime: true,
+		},
+		"should fetch renewal information recorded for a different certificate": {
+			existing:    renewalInfo("cafe", nowTime.Add(time.Hour)),
+			info:        fetched,
+			called:      true,
+			expected:    fetched,
+			renewalTime: true,
+		},
+		"should clear renewal information if the issuer does not support it": {
+			existing: renewalInfo("beef", nowTime.Add(-time.Minute)),
+			err:      issuer.ErrRenewalInfoNotSupported,
+			called:   true,
+		},
+		"should keep existing renewal information if fetching it fails": {
+			existing: renewalInfo("beef", nowTime.Add(-time.Minute)),
+			err:      fmt.Errorf("server unavailable"),
+			called:   true,
+			expected: renewalInfo("beef", nowTime.Add(-time.Minute)),
+		},
+		"should clear renewal information for a different certificate if fetching it fails": {
+			existing: renewalInfo("cafe", nowTime.Add(time.Hour)),
+			err:      fmt.Errorf("server unavailable"),
+			called:   true,
+		},
+		"should clear renewal information if the issuer is not a renewal information provider": {
+			existing:   current,
+			noProvider: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			fakeIssuer := &fake.Issuer{
+				FakeRenewalInfo: func(_ context.Context, _ *cmapi.Certificate, c *x509.Certificate) (*cmapi.CertificateRenewalInfo, error) {
+					called = true
+					if c != cert {
+						t.Errorf("unexpected certificate passed to RenewalInfo")
+					}
+					return test.info, test.err
+				},
+			}
+			var i issuer.Interface = fakeIssuer
+			if test.noProvider {
+				i = noRenewalInfoIssuer{fakeIssuer}
+			}
+
+			c := &controller{
+				clock: clock.NewFakeClock(nowTime),
+				calculateRenewalTime: func(context.Context, *x509.Certificate, *cmapi.Certificate) time.Time {
+					return nowTime.Add(time.Hour)
+				},
+			}
+			crt := gen.Certificate("test")
+			crt.Status.RenewalInfo = test.existing.DeepCopy()
+
+			c.syncRenewalInfo(context.Background(), i, crt, cert)
+
+			if called != test.called {
+				t.Errorf("expected RenewalInfo to be called %t, got %t", test.called, called)
+			}
+			if !reflect.DeepEqual(crt.Status.RenewalInfo, test.expected) {
+				t.Errorf("expected renewal info %+v, got %+v", test.expected, crt.Status.RenewalInfo)
+			}
+			if test.renewalTime != (crt.Status.RenewalTime != nil) {
+				t.Errorf("expected renewal time to be updated %t, got %v", test.renewalTime, crt.Status.RenewalTime)
+			}
+		})
+	}
+}
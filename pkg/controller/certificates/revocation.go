@@ -0,0 +1,242 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util"
+	"github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/pkg/util/kube"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+const (
+	errorRevocationFailed = "RevocationFailed"
+
+	reasonRevoked = "Revoked"
+
+	successCertificateRevoked = "CertRevoked"
+)
+
+// syncRevocationFinalizer ensures the revocation finalizer is present on the
+// Certificate if, and only if, its RevocationPolicy is RevokeOnDelete.
+// It returns true if the Certificate has been updated, in which case the
+// caller should stop processing as the update will trigger another sync.
+func (c *controller) syncRevocationFinalizer(crt *v1alpha1.Certificate) (bool, error) {
+	wanted := crt.Spec.RevocationPolicy == v1alpha1.RevocationPolicyRevokeOnDelete
+	if wanted == util.Contains(crt.Finalizers, v1alpha1.RevocationFinalizer) {
+		return false, nil
+	}
+
+	crt = crt.DeepCopy()
+	if wanted {
+		crt.Finalizers = append(crt.Finalizers, v1alpha1.RevocationFinalizer)
+	} else {
+		crt.Finalizers = removeFinalizer(crt.Finalizers, v1alpha1.RevocationFinalizer)
+	}
+
+	_, err := c.cmClient.CertmanagerV1alpha1().Certificates(crt.Namespace).Update(crt)
+	return true, err
+}
+
+// finalizeCertificate handles a Certificate that is being deleted. If the
+// revocation finalizer is present, the currently issued certificate is
+// revoked before the finalizer is removed to allow the deletion to proceed.
+func (c *controller) finalizeCertificate(ctx context.Context, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx, "finalizer")
+	if !util.Contains(crt.Finalizers, v1alpha1.RevocationFinalizer) {
+		return nil
+	}
+
+	// changes to the status made whilst revoking are discarded, as the
+	// Certificate is being deleted.
+	if err := c.revokeOnDelete(ctx, crt.DeepCopy()); err != nil {
+		return err
+	}
+
+	crt = crt.DeepCopy()
+
+	log.V(logf.DebugLevel).Info("removing revocation finalizer")
+	crt.Finalizers = removeFinalizer(crt.Finalizers, v1alpha1.RevocationFinalizer)
+	_, err := c.cmClient.CertmanagerV1alpha1().Certificates(crt.Namespace).Update(crt)
+	return err
+}
+
+// revokeOnDelete revokes the certificate stored in the Certificate's secret.
+// Errors that cannot be resolved by retrying, such as a missing issuer or
+// an issuer that does not support revocation, are recorded as events and
+// do not block the deletion of the Certificate.
+func (c *controller) revokeOnDelete(ctx context.Context, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx, "finalizer")
+
+	cert, err := kube.SecretTLSCert(ctx, c.secretLister, crt.Namespace, crt.Spec.SecretName)
+	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
+		log.V(logf.DebugLevel).Info("no certificate to revoke")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pki.IsTemporaryCertificate(cert) || certificateRevoked(crt, cert) {
+		return nil
+	}
+
+	issuerObj, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if k8sErrors.IsNotFound(err) {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, errorIssuerNotFound, "Not revoking certificate: %v", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	i, err := c.issuerFactory.IssuerFor(issuerObj)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, errorIssuerInit, "Internal error initialising issuer: %v", err)
+		return nil
+	}
+
+	err = c.revoke(ctx, i, crt, cert, v1alpha1.RevocationReasonCessationOfOperation)
+	if err == issuer.ErrRevocationNotSupported {
+		return nil
+	}
+	return err
+}
+
+// revokeRequested handles a revocation that has been manually requested by
+// setting the RevocationRequested condition on the Certificate.
+// Once the certificate has been revoked, a new certificate is issued. Unless
+// the certificate was revoked because it has been superseded, a new private
+// key is generated for it, as the revoked certificate's key may have been
+// compromised.
+func (c *controller) revokeRequested(ctx context.Context, issuerObj v1alpha1.GenericIssuer, i issuer.Interface, crt *v1alpha1.Certificate, cert *x509.Certificate, reason v1alpha1.RevocationReason) error {
+	if cert == nil || pki.IsTemporaryCertificate(cert) {
+		c.recorder.Event(crt, corev1.EventTypeWarning, errorRevocationFailed, "Not revoking certificate as no certificate has been issued")
+		apiutil.RemoveCertificateCondition(crt, v1alpha1.CertificateConditionRevocationRequested)
+		return nil
+	}
+
+	if !certificateRevoked(crt, cert) {
+		err := c.revoke(ctx, i, crt, cert, reason)
+		if err == issuer.ErrRevocationNotSupported {
+			apiutil.RemoveCertificateCondition(crt, v1alpha1.CertificateConditionRevocationRequested)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if reason != v1alpha1.RevocationReasonSuperseded {
+		// the new private key is stored as the next private key, and the
+		// certificate is issued for it once the secret has been resynced
+		if err := c.generateNextPrivateKey(ctx, crt); err != nil {
+			return err
+		}
+		apiutil.RemoveCertificateCondition(crt, v1alpha1.CertificateConditionRevocationRequested)
+		return nil
+	}
+
+	apiutil.RemoveCertificateCondition(crt, v1alpha1.CertificateConditionRevocationRequested)
+	return c.issue(ctx, issuerObj, i, crt)
+}
+
+// generateNextPrivateKey generates a new private key for the Certificate and
+// stores it as the next private key in its target secret, so that it is used
+// to issue the next certificate regardless of the KeyRotationPolicy.
+func (c *controller) generateNextPrivateKey(ctx context.Context, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	key, err := pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		return err
+	}
+	keyPEM, err := pki.EncodePrivateKey(key, crt.Spec.KeyEncoding)
+	if err != nil {
+		return err
+	}
+	if _, err := c.updateSecret(ctx, crt, crt.Namespace, nil, keyPEM, nil); err != nil {
+		log.Error(err, "error saving private key")
+		c.recorder.Event(crt, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+		return err
+	}
+	c.recorder.Event(crt, corev1.EventTypeNormal, reasonPrivateKeyGenerated, "Generated new private key")
+
+	return nil
+}
+
+// revoke revokes the given certificate with the issuer and records the
+// revocation in the Certificate's status.
+// It will not actually submit the resource to the apiserver.
+func (c *controller) revoke(ctx context.Context, i issuer.Interface, crt *v1alpha1.Certificate, cert *x509.Certificate, reason v1alpha1.RevocationReason) error {
+	log := logf.FromContext(ctx).WithValues("serial_number", serialNumber(cert), "reason", reason)
+
+	err := i.Revoke(ctx, crt, cert, reason)
+	if err == issuer.ErrRevocationNotSupported {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, errorRevocationFailed, "Not revoking certificate: %v", err)
+		return err
+	}
+	if err != nil {
+		log.Error(err, "error revoking certificate")
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, errorRevocationFailed, "Error revoking certificate: %v", err)
+		return err
+	}
+
+	crt.Status.Revocation = &v1alpha1.CertificateRevocation{
+		SerialNumber:   serialNumber(cert),
+		Reason:         reason,
+		RevocationTime: metav1.NewTime(c.clock.Now()),
+	}
+	apiutil.SetCertificateCondition(crt, v1alpha1.CertificateConditionReady, v1alpha1.ConditionFalse, reasonRevoked,
+		fmt.Sprintf("Certificate has been revoked with reason %s", reason))
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, successCertificateRevoked, "Certificate with serial number %s revoked", serialNumber(cert))
+	log.Info("certificate revoked")
+
+	return nil
+}
+
+// certificateRevoked returns true if the given certificate is the one most
+// recently revoked for the Certificate.
+func certificateRevoked(crt *v1alpha1.Certificate, cert *x509.Certificate) bool {
+	return crt.Status.Revocation != nil && crt.Status.Revocation.SerialNumber == serialNumber(cert)
+}
+
+// serialNumber returns the hex encoded serial number of the certificate.
+func serialNumber(cert *x509.Certificate) string {
+	return cert.SerialNumber.Text(16)
+}
+
+func removeFinalizer(finalizers []string, finalizer string) []string {
+	var out []string
+	for _, f := range finalizers {
+		if f != finalizer {
+			out = append(out, f)
+		}
+	}
+	return out
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	clock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	"github.com/leki75/cert-manager/pkg/issuer/fake"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func TestRevocation(t *testing.T) {
+	nowTime := time.Now()
+	nowMetaTime := metav1.NewTime(nowTime)
+	fixedClock := clock.NewFakeClock(nowTime)
+
+	exampleCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+	)
+	readyIssuer := gen.Issuer("test",
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmapi.ConditionTrue,
+		}),
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+	)
+
+	pk1 := generatePrivateKey(t)
+	pk1PEM := pki.EncodePKCS1PrivateKey(pk1)
+	cert1PEM := generateSelfSignedCert(t, exampleCert, nil, pk1, nowTime, nowTime.Add(time.Hour*12))
+	cert1, err := pki.DecodeX509CertificateBytes(cert1PEM)
+	if err != nil {
+		t.Fatalf("Error decoding test cert1 bytes: %v", err)
+	}
+	cert1RenewedPEM := generateSelfSignedCert(t, exampleCert, nil, pk1, nowTime, nowTime.Add(time.Hour*12))
+
+	outputSecret := func(certPEM []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gen.DefaultTestNamespace,
+				Name:      "output",
+				SelfLink:  "abc",
+				Labels: map[string]string{
+					cmapi.CertificateNameKey: "test",
+				},
+				Annotations: map[string]string{
+					"certmanager.k8s.io/alt-names":   "example.com",
+					"certmanager.k8s.io/common-name": "example.com",
+					"certmanager.k8s.io/ip-sans":     "",
+					"certmanager.k8s.io/uri-sans":    "",
+					"certmanager.k8s.io/email-sans":  "",
+					"certmanager.k8s.io/issuer-kind": "Issuer",
+					"certmanager.k8s.io/issuer-name": "test",
+					"certmanager.k8s.io/duration":    "2160h0m0s",
+				},
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: pk1PEM,
+				TLSCAKey:                nil,
+			},
+		}
+	}
+	expectRevoke := func(expected cmapi.RevocationReason, err error) func(context.Context, *cmapi.Certificate, *x509.Certificate, cmapi.RevocationReason) error {
+		return func(_ context.Context, _ *cmapi.Certificate, cert *x509.Certificate, reason cmapi.RevocationReason) error {
+			if cert.SerialNumber.Cmp(cert1.SerialNumber) != 0 {
+				t.Errorf("expected certificate with serial number %s to be revoked, got %s", cert1.SerialNumber, cert.SerialNumber)
+			}
+			if reason != expected {
+				t.Errorf("expected revocation reason %q, got %q", expected, reason)
+			}
+			return err
+		}
+	}
+
+	revokeOnDeleteCert := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateRevocationPolicy(cmapi.RevocationPolicyRevokeOnDelete),
+	)
+	deletedCert := gen.CertificateFrom(revokeOnDeleteCert)
+	deletedCert.DeletionTimestamp = &nowMetaTime
+	deletedCert.Finalizers = []string{cmapi.RevocationFinalizer}
+	deletedCertFinalized := gen.CertificateFrom(deletedCert)
+	deletedCertFinalized.Finalizers = nil
+
+	revocationRequestedCert := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionRevocationRequested,
+			Status:             cmapi.ConditionTrue,
+			Reason:             string(cmapi.RevocationReasonKeyCompromise),
+			Message:            "Certificate revocation manually requested",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+
+	supersededRequestedCert := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionRevocationRequested,
+			Status:             cmapi.ConditionTrue,
+			Reason:             string(cmapi.RevocationReasonSuperseded),
+			Message:            "Certificate revocation manually requested",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+
+	tests := map[string]controllerFixture{
+		"should add the revocation finalizer if the revocation policy is RevokeOnDelete": {
+			Issuer:      readyIssuer,
+			Certificate: *revokeOnDeleteCert,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{revokeOnDeleteCert},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						func() *cmapi.Certificate {
+							crt := gen.CertificateFrom(revokeOnDeleteCert)
+							crt.Finalizers = []string{cmapi.RevocationFinalizer}
+							return crt
+						}(),
+					)),
+				},
+			},
+		},
+		"should revoke the certificate and remove the finalizer when deleted": {
+			Issuer:      readyIssuer,
+			Certificate: *deletedCert,
+			IssuerImpl: &fake.Issuer{
+				FakeRevoke: expectRevoke(cmapi.RevocationReasonCessationOfOperation, nil),
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{outputSecret(cert1PEM)},
+				CertManagerObjects: []runtime.Object{deletedCert},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						deletedCertFinalized,
+					)),
+				},
+			},
+		},
+		"should remove the finalizer when deleted if the issuer does not support revocation": {
+			Issuer:      readyIssuer,
+			Certificate: *deletedCert,
+			IssuerImpl: &fake.Issuer{
+				FakeRevoke: expectRevoke(cmapi.RevocationReasonCessationOfOperation, issuer.ErrRevocationNotSupported),
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{outputSecret(cert1PEM)},
+				CertManagerObjects: []runtime.Object{deletedCert},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						deletedCertFinalized,
+					)),
+				},
+			},
+		},
+		"should keep the finalizer when deleted if revocation fails": {
+			Issuer:      readyIssuer,
+			Certificate: *deletedCert,
+			IssuerImpl: &fake.Issuer{
+				FakeRevoke: expectRevoke(cmapi.RevocationReasonCessationOfOperation, fmt.Errorf("server unavailable")),
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{outputSecret(cert1PEM)},
+				CertManagerObjects: []runtime.Object{deletedCert},
+			},
+			Err: true,
+		},
+		"should remove the finalizer when deleted if no certificate has been issued": {
+			Issuer:      readyIssuer,
+			Certificate: *deletedCert,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{deletedCert},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						gen.DefaultTestNamespace,
+						deletedCertFinalized,
+					)),
+				},
+			},
+		},
+		"should revoke the certificate and generate a new private key when revocation is manually requested": {
+			Issuer:      readyIssuer,
+			Certificate: *revocationRequestedCert,
+			IssuerImpl: &fake.Issuer{
+				FakeRevoke: expectRevoke(cmapi.RevocationReasonKeyCompromise, nil),
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("unexpected issue call before the new private key has been stored")
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{outputSecret(cert1PEM)},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "Revoked",
+								Message:            "Certificate has been revoked with reason KeyCompromise",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateRevocation(cmapi.CertificateRevocation{
+								SerialNumber:   cert1.SerialNumber.Text(16),
+								Reason:         cmapi.RevocationReasonKeyCompromise,
+								RevocationTime: nowMetaTime,
+							}),
+						),
+					)),
+					testpkg.NewCustomMatch(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						outputSecret(cert1PEM),
+					), func(exp, actual coretesting.Action) error {
+						secret, ok := actual.(coretesting.UpdateAction).GetObject().(*corev1.Secret)
+						if !ok {
+							return fmt.Errorf("expected a Secret to be updated")
+						}
+						if !bytes.Equal(secret.Data[corev1.TLSCertKey], cert1PEM) || !bytes.Equal(secret.Data[corev1.TLSPrivateKeyKey], pk1PEM) {
+							return fmt.Errorf("expected the revoked certificate and its private key to be kept until a new certificate is issued")
+						}
+						nextKey, err := pki.DecodePrivateKeyBytes(secret.Data[cmapi.NextPrivateKeySecretKey])
+						if err != nil {
+							return fmt.Errorf("expected a next private key to be stored: %v", err)
+						}
+						if matches, err := pki.PublicKeyMatchesCertificate(nextKey.Public(), cert1); err != nil || matches {
+							return fmt.Errorf("expected the public key of the next private key to differ from the revoked certificate")
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"should revoke and re-issue the certificate when revocation is manually requested as superseded": {
+			Issuer:      readyIssuer,
+			Certificate: *supersededRequestedCert,
+			IssuerImpl: &fake.Issuer{
+				FakeRevoke: expectRevoke(cmapi.RevocationReasonSuperseded, nil),
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1RenewedPEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{outputSecret(cert1PEM)},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "Revoked",
+								Message:            "Certificate has been revoked with reason Superseded",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateRevocation(cmapi.CertificateRevocation{
+								SerialNumber:   cert1.SerialNumber.Text(16),
+								Reason:         cmapi.RevocationReasonSuperseded,
+								RevocationTime: nowMetaTime,
+							}),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						outputSecret(cert1RenewedPEM),
+					)),
+				},
+			},
+		},
+		"should re-issue a certificate that has already been revoked": {
+			Issuer: readyIssuer,
+			Certificate: *gen.CertificateFrom(exampleCert,
+				gen.SetCertificateRevocation(cmapi.CertificateRevocation{
+					SerialNumber:   cert1.SerialNumber.Text(16),
+					Reason:         cmapi.RevocationReasonSuperseded,
+					RevocationTime: nowMetaTime,
+				}),
+			),
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1RenewedPEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{outputSecret(cert1PEM)},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "Revoked",
+								Message:            "Certificate has been revoked with reason Superseded",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateRevocation(cmapi.CertificateRevocation{
+								SerialNumber:   cert1.SerialNumber.Text(16),
+								Reason:         cmapi.RevocationReasonSuperseded,
+								RevocationTime: nowMetaTime,
+							}),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						outputSecret(cert1RenewedPEM),
+					)),
+				},
+			},
+		},
+	}
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			test.Clock = fixedClock
+			test.Setup(t)
+			crtCopy := test.Certificate.DeepCopy()
+			err := test.Controller.Sync(test.Ctx, crtCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, crtCopy, err)
+		})
+	}
+}
@@ -17,13 +17,16 @@ limitations under the License.
 package certificates
 
 import (
+	"bytes"
 	"context"
 	"crypto"
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +42,7 @@ import (
 	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
 	"github.com/leki75/cert-manager/pkg/feature"
 	"github.com/leki75/cert-manager/pkg/issuer"
 	logf "github.com/leki75/cert-manager/pkg/logs"
@@ -55,12 +59,18 @@ const (
 	errorSavingCertificate   = "SaveCertError"
 	errorConfig              = "ConfigError"
 	errorDuplicateSecretName = "DuplicateSecretNameError"
+	errorKeystorePassword    = "KeystorePasswordError"
+	errorIssueFailed         = "IssueFailed"
 
 	reasonIssuingCertificate  = "IssueCert"
 	reasonRenewingCertificate = "RenewCert"
+	reasonDoesNotMatch        = "DoesNotMatch"
+	reasonPolicyViolation     = "PolicyViolation"
+	reasonNotPermitted        = "NotPermitted"
 
 	successCertificateIssued  = "CertIssued"
 	successCertificateRenewed = "CertRenewed"
+	successKeystoresUpdated   = "KeystoresUpdated"
 
 	messageErrorSavingCertificate = "Error saving TLS certificate: "
 )
@@ -69,6 +79,14 @@ const (
 	TLSCAKey = "ca.crt"
 )
 
+const (
+	// issuanceBackoffInitial is the time to wait before retrying issuance
+	// after the first failed attempt. It is doubled for each consecutive
+	// failure, up to issuanceBackoffMax.
+	issuanceBackoffInitial = time.Minute * 5
+	issuanceBackoffMax     = time.Hour * 32
+)
+
 var (
 	certificateGvk = v1alpha1.SchemeGroupVersion.WithKind("Certificate")
 )
@@ -79,6 +97,16 @@ func (c *controller) Sync(ctx context.Context, crt *v1alpha1.Certificate) (err e
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
 
+	// finalizers can only be changed by updating the resource itself, and
+	// any changes to the status of a Certificate that is being deleted can
+	// be discarded.
+	if crt.DeletionTimestamp != nil {
+		return c.finalizeCertificate(ctx, crt)
+	}
+	if updated, err := c.syncRevocationFinalizer(crt); err != nil || updated {
+		return err
+	}
+
 	crtCopy := crt.DeepCopy()
 	defer func() {
 		if _, saveErr := c.updateCertificateStatus(ctx, crt, crtCopy); saveErr != nil {
@@ -103,7 +131,7 @@ func (c *controller) Sync(ctx context.Context, crt *v1alpha1.Certificate) (err e
 	// update certificate expiry metric
 	defer c.metrics.UpdateCertificateExpiry(crtCopy, c.secretLister)
 	dbg.Info("Update certificate status if required")
-	c.setCertificateStatus(crtCopy, key, cert)
+	c.setCertificateStatus(ctx, crtCopy, key, cert)
 
 	el := validation.ValidateCertificate(crtCopy)
 	if len(el) > 0 {
@@ -147,12 +175,35 @@ func (c *controller) Sync(ctx context.Context, crt *v1alpha1.Certificate) (err e
 	}
 	dbg.Info("Fetched issuer resource referenced by certificate", "issuer_name", crtCopy.Spec.IssuerRef.Name)
 
+	allowed, err := issuer.NamespaceAllowed(c.namespaceLister, issuerObj, crtCopy.Namespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		msg := fmt.Sprintf("ClusterIssuer %q does not permit Certificates in namespace %q", issuerObj.GetObjectMeta().Name, crtCopy.Namespace)
+		c.recorder.Event(crtCopy, corev1.EventTypeWarning, reasonNotPermitted, msg)
+		apiutil.SetCertificateCondition(crtCopy, v1alpha1.CertificateConditionReady, v1alpha1.ConditionFalse, reasonNotPermitted, msg)
+		return nil
+	}
+
 	el = validation.ValidateCertificateForIssuer(crtCopy, issuerObj)
 	if len(el) > 0 {
 		c.recorder.Eventf(crtCopy, corev1.EventTypeWarning, "BadConfig", "Resource validation failed: %v", el.ToAggregate())
 		return nil
 	}
 
+	policies, err := c.certificatePolicyLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	el = validation.ValidateCertificateForPolicies(crtCopy, policies)
+	if len(el) > 0 {
+		msg := fmt.Sprintf("Certificate does not satisfy CertificatePolicy: %v", el.ToAggregate())
+		c.recorder.Event(crtCopy, corev1.EventTypeWarning, reasonPolicyViolation, msg)
+		apiutil.SetCertificateCondition(crtCopy, v1alpha1.CertificateConditionReady, v1alpha1.ConditionFalse, reasonPolicyViolation, msg)
+		return nil
+	}
+
 	dbg.Info("Certificate passed all validation checks")
 
 	issuerReady := apiutil.IssuerHasCondition(issuerObj, v1alpha1.IssuerCondition{
@@ -170,42 +221,87 @@ func (c *controller) Sync(ctx context.Context, crt *v1alpha1.Certificate) (err e
 		return nil
 	}
 
-	if isTemporaryCertificate(cert) {
+	// check if a revocation of the certificate has been manually requested
+	if reason, ok := apiutil.CertificateRevocationRequested(crtCopy); ok {
+		dbg.Info("revoking certificate due to revocation being manually requested", "reason", reason)
+		return c.revokeRequested(ctx, issuerObj, i, crtCopy, cert, reason)
+	}
+
+	if pki.IsTemporaryCertificate(cert) {
 		dbg.Info("Temporary certificate found - calling 'issue'")
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 
 	if key == nil || cert == nil {
 		dbg.Info("Invoking issue function as existing certificate does not exist")
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 
 	// begin checking if the TLS certificate is valid/needs a re-issue or renew
 	matches, matchErrs := c.certificateMatchesSpec(crtCopy, key, cert)
 	if !matches {
 		dbg.Info("invoking issue function due to certificate not matching spec", "diff", strings.Join(matchErrs, ", "))
-		return c.issue(ctx, i, crtCopy)
+		c.recorder.Eventf(crtCopy, corev1.EventTypeNormal, reasonDoesNotMatch, "Re-issuing certificate as it does not match its spec: %s", strings.Join(matchErrs, ", "))
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 
+	// a revoked certificate must always be replaced
+	if certificateRevoked(crtCopy, cert) {
+		dbg.Info("invoking issue function as the existing certificate has been revoked")
+		return c.issue(ctx, issuerObj, i, crtCopy)
+	}
+
+	// check if a renewal of the certificate has been manually requested
+	if apiutil.CertificateRenewalRequested(crtCopy) {
+		dbg.Info("invoking issue function due to renewal being manually requested")
+		return c.issue(ctx, issuerObj, i, crtCopy)
+	}
+
+	// record the renewal window suggested by the issuer, if any, so that it
+	// is taken into account when deciding whether to renew
+	c.syncRenewalInfo(ctx, i, crtCopy, cert)
+
 	// check if the certificate needs renewal
-	needsRenew := c.certificateNeedsRenew(ctx, cert, crt)
+	needsRenew := c.certificateNeedsRenew(ctx, cert, crtCopy)
 	if needsRenew {
 		dbg.Info("invoking issue function due to certificate needing renewal")
-		return c.issue(ctx, i, crtCopy)
+		return c.issue(ctx, issuerObj, i, crtCopy)
 	}
 	// end checking if the TLS certificate is valid/needs a re-issue or renew
 
+	// check that any keystores stored in the secret are still up to date,
+	// for example if the referenced password has been changed
+	secret, err := c.secretLister.Secrets(crtCopy.Namespace).Get(crtCopy.Spec.SecretName)
+	if err != nil {
+		return err
+	}
+	staleKeystores, err := c.keystoresMatchSpec(crtCopy, secret)
+	if err != nil {
+		c.recorder.Eventf(crtCopy, corev1.EventTypeWarning, errorKeystorePassword, "Error reading keystore password: %v", err)
+		return err
+	}
+	if len(staleKeystores) > 0 {
+		dbg.Info("updating keystores in secret as they are out of date", "diff", strings.Join(staleKeystores, ", "))
+		if _, err := c.updateSecret(ctx, crtCopy, crtCopy.Namespace, secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], secret.Data[TLSCAKey]); err != nil {
+			c.recorder.Event(crtCopy, corev1.EventTypeWarning, errorSavingCertificate, messageErrorSavingCertificate+err.Error())
+			return err
+		}
+		c.recorder.Event(crtCopy, corev1.EventTypeNormal, successKeystoresUpdated, "Keystores updated successfully")
+	} else if err := c.updateSecretTemplate(ctx, secret, crtCopy); err != nil {
+		return err
+	}
+
 	dbg.Info("Certificate does not need updating. Scheduling renewal.")
 	// If the Certificate is valid and up to date, we schedule a renewal in
 	// the future.
-	c.scheduleRenewal(ctx, crt)
+	c.scheduleRenewal(ctx, crtCopy)
 
 	return nil
 }
 
 // setCertificateStatus will update the status subresource of the certificate.
 // It will not actually submit the resource to the apiserver.
-func (c *controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.Signer, cert *x509.Certificate) {
+func (c *controller) setCertificateStatus(ctx context.Context, crt *v1alpha1.Certificate, key crypto.Signer, cert *x509.Certificate) {
 	if key == nil || cert == nil {
 		apiutil.SetCertificateCondition(crt, v1alpha1.CertificateConditionReady, v1alpha1.ConditionFalse, "NotFound", "Certificate does not exist")
 		return
@@ -213,6 +309,10 @@ func (c *controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.
 
 	metaNotAfter := metav1.NewTime(cert.NotAfter)
 	crt.Status.NotAfter = &metaNotAfter
+	metaNotBefore := metav1.NewTime(cert.NotBefore)
+	crt.Status.NotBefore = &metaNotBefore
+	metaRenewalTime := metav1.NewTime(c.calculateRenewalTime(ctx, cert, crt))
+	crt.Status.RenewalTime = &metaRenewalTime
 
 	// Derive & set 'Ready' condition on Certificate resource
 	matches, matchErrs := c.certificateMatchesSpec(crt, key, cert)
@@ -220,14 +320,19 @@ func (c *controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.
 	reason := ""
 	message := ""
 	switch {
-	case isTemporaryCertificate(cert):
+	case pki.IsTemporaryCertificate(cert):
 		reason = "TemporaryCertificate"
 		message = "Certificate issuance in progress. Temporary certificate issued."
-		// clear the NotAfter field as it is not relevant to the user
+		// clear the validity fields as they are not relevant to the user
 		crt.Status.NotAfter = nil
+		crt.Status.NotBefore = nil
+		crt.Status.RenewalTime = nil
 	case cert.NotAfter.Before(c.clock.Now()):
 		reason = "Expired"
 		message = fmt.Sprintf("Certificate has expired on %s", cert.NotAfter.Format(time.RFC822))
+	case certificateRevoked(crt, cert):
+		reason = reasonRevoked
+		message = fmt.Sprintf("Certificate has been revoked with reason %s", crt.Status.Revocation.Reason)
 	case !matches:
 		reason = "DoesNotMatch"
 		message = strings.Join(matchErrs, ", ")
@@ -242,12 +347,144 @@ func (c *controller) setCertificateStatus(crt *v1alpha1.Certificate, key crypto.
 	return
 }
 
-func (c *controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypto.Signer, cert *x509.Certificate) (bool, []string) {
+// subjectMatchesSpec compares the distinguished name fields configured in
+// the Certificate's subject block against those on the x509 certificate.
+func subjectMatchesSpec(crt *v1alpha1.Certificate, cert *x509.Certificate) []string {
+	// issuers may set their own subject fields if none are requested
+	if crt.Spec.Subject == nil {
+		return nil
+	}
+
 	var errs []string
 
-	// TODO: add checks for KeySize, KeyAlgorithm fields
-	// TODO: add checks for Organization field
-	// TODO: add checks for IsCA field
+	expected := pki.SubjectForCertificate(crt)
+	check := func(name string, expected, actual []string) {
+		if !util.EqualUnsorted(expected, actual) {
+			errs = append(errs, fmt.Sprintf("%s on TLS certificate not up to date: %q", name, actual))
+		}
+	}
+
+	check("Countries", expected.Country, cert.Subject.Country)
+	check("Organizational units", expected.OrganizationalUnit, cert.Subject.OrganizationalUnit)
+	check("Localities", expected.Locality, cert.Subject.Locality)
+	check("Provinces", expected.Province, cert.Subject.Province)
+	check("Street addresses", expected.StreetAddress, cert.Subject.StreetAddress)
+	check("Postal codes", expected.PostalCode, cert.Subject.PostalCode)
+
+	if expected.SerialNumber != cert.Subject.SerialNumber {
+		errs = append(errs, fmt.Sprintf("Subject serial number on TLS certificate not up to date: %q", cert.Subject.SerialNumber))
+	}
+
+	return errs
+}
+
+// issuerTypeForCertificate returns the type of the issuer referenced by the
+// Certificate, or an empty string if it cannot be determined.
+func (c *controller) issuerTypeForCertificate(crt *v1alpha1.Certificate) string {
+	issuerObj, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		return ""
+	}
+	issuerType, err := apiutil.NameForIssuer(issuerObj)
+	if err != nil {
+		return ""
+	}
+	return issuerType
+}
+
+// issuerPreservesSubject returns true if issuers of the given type always
+// use the subject requested in the CSR.
+func issuerPreservesSubject(issuerType string) bool {
+	switch issuerType {
+	case apiutil.IssuerCA, apiutil.IssuerSelfSigned, apiutil.IssuerVenafi:
+		return true
+	}
+	return false
+}
+
+// usagesMatchSpec checks that each of the key usages requested on the
+// Certificate, or the default key usages if none are requested, is present
+// on the x509 certificate.
+func usagesMatchSpec(crt *v1alpha1.Certificate, cert *x509.Certificate, issuerType string) []string {
+	// the usages of certificates signed by Vault are set by the Vault role
+	// rather than the usages requested
+	if issuerType == apiutil.IssuerVault {
+		return nil
+	}
+
+	ku, ekus, err := pki.KeyUsagesForCertificate(crt)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	// key encipherment only applies to RSA keys, and CAs such as Let's
+	// Encrypt omit it from certificates for other key types
+	if _, ok := cert.PublicKey.(*rsa.PublicKey); !ok {
+		ku &^= x509.KeyUsageKeyEncipherment
+	}
+
+	var errs []string
+	if cert.KeyUsage&ku != ku {
+		errs = append(errs, fmt.Sprintf("Key usages on TLS certificate not up to date: %d", cert.KeyUsage))
+	}
+	for _, eku := range ekus {
+		found := false
+		for _, certEKU := range cert.ExtKeyUsage {
+			if eku == certEKU {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("Extended key usages on TLS certificate not up to date: %v", cert.ExtKeyUsage))
+			break
+		}
+	}
+
+	return errs
+}
+
+// issuerCACertificates returns the CA certificates currently used by the
+// issuer of the Certificate to sign certificates.
+// Only CA issuers are supported. It returns nil if the issuer is of another
+// type or its CA cannot be determined.
+func (c *controller) issuerCACertificates(crt *v1alpha1.Certificate) []*x509.Certificate {
+	issuerObj, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil || issuerObj.GetSpec().CA == nil {
+		return nil
+	}
+	secret, err := c.secretLister.Secrets(c.resourceNamespace(issuerObj)).Get(issuerObj.GetSpec().CA.SecretName)
+	if err != nil {
+		return nil
+	}
+	cas, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil
+	}
+	return cas
+}
+
+// certificateSignedByCA returns true if the certificate was signed by one of
+// the given CA certificates.
+func certificateSignedByCA(cert *x509.Certificate, cas []*x509.Certificate) bool {
+	for _, ca := range cas {
+		if ca.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// certificateDuration returns the duration requested for the Certificate.
+func certificateDuration(crt *v1alpha1.Certificate) time.Duration {
+	if crt.Spec.Duration != nil {
+		return crt.Spec.Duration.Duration
+	}
+	return v1alpha1.DefaultCertificateDuration
+}
+
+func (c *controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypto.Signer, cert *x509.Certificate) (bool, []string) {
+	var errs []string
 
 	// check if the private key is the corresponding pair to the certificate
 	matches, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
@@ -257,12 +494,39 @@ func (c *controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypt
 		errs = append(errs, fmt.Sprintf("Certificate private key does not match certificate"))
 	}
 
+	// validate the private key algorithm and size are correct
+	errs = append(errs, pki.PrivateKeyMatchesSpec(key, crt.Spec)...)
+
 	// validate the common name is correct
 	expectedCN := pki.CommonNameForCertificate(crt)
 	if expectedCN != cert.Subject.CommonName {
 		errs = append(errs, fmt.Sprintf("Common name on TLS certificate not up to date: %q", cert.Subject.CommonName))
 	}
 
+	// validate the subject is correct. Only issuers that are known to copy
+	// the subject of the CSR onto the certificate are checked, as others
+	// (e.g. Vault roles) may override it, which would otherwise cause the
+	// certificate to be re-issued on every sync.
+	issuerType := c.issuerTypeForCertificate(crt)
+	if issuerPreservesSubject(issuerType) {
+		// Issuers may set their own organization if one is not requested,
+		// so it is only checked if set.
+		if len(crt.Spec.Organization) > 0 && !util.EqualUnsorted(crt.Spec.Organization, cert.Subject.Organization) {
+			errs = append(errs, fmt.Sprintf("Organization on TLS certificate not up to date: %q", cert.Subject.Organization))
+		}
+
+		errs = append(errs, subjectMatchesSpec(crt, cert)...)
+	}
+
+	// validate the CA flag is correct
+	if crt.Spec.IsCA != cert.IsCA {
+		errs = append(errs, fmt.Sprintf("CA flag on TLS certificate not up to date: %t", cert.IsCA))
+	}
+
+	// validate the requested usages are present on the certificate. Issuers
+	// may add further usages, so only the requested usages are checked.
+	errs = append(errs, usagesMatchSpec(crt, cert, issuerType)...)
+
 	// validate the dns names are correct
 	expectedDNSNames := pki.DNSNamesForCertificate(crt)
 	if !util.EqualUnsorted(cert.DNSNames, expectedDNSNames) {
@@ -274,6 +538,16 @@ func (c *controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypt
 		errs = append(errs, fmt.Sprintf("IP addresses on TLS certificate not up to date: %q", pki.IPAddressesToString(cert.IPAddresses)))
 	}
 
+	// validate the uri SANs are correct
+	if !util.EqualUnsorted(pki.URLsToString(cert.URIs), crt.Spec.URISANs) {
+		errs = append(errs, fmt.Sprintf("URI SANs on TLS certificate not up to date: %q", pki.URLsToString(cert.URIs)))
+	}
+
+	// validate the email SANs are correct
+	if !util.EqualUnsorted(cert.EmailAddresses, crt.Spec.EmailSANs) {
+		errs = append(errs, fmt.Sprintf("Email SANs on TLS certificate not up to date: %q", cert.EmailAddresses))
+	}
+
 	// get a copy of the current secret resource
 	// Note that we already know that it exists, no need to check for errors
 	// TODO: Refactor so that the secret is passed as argument?
@@ -289,9 +563,213 @@ func (c *controller) certificateMatchesSpec(crt *v1alpha1.Certificate, key crypt
 		errs = append(errs, fmt.Sprintf("Issuer kind of the certificate is not up to date: %q", secret.Annotations[v1alpha1.IssuerKindAnnotationKey]))
 	}
 
+	// validate that the certificate was signed by the current CA of the
+	// issuer, so that certificates are re-issued when the CA is rotated
+	if cas := c.issuerCACertificates(crt); len(cas) > 0 && !certificateSignedByCA(cert, cas) {
+		errs = append(errs, fmt.Sprintf("Certificate was not signed by the current CA of the issuer: %q", cert.Issuer.String()))
+	}
+
+	// validate that the duration is correct. Issuers may shorten the
+	// requested duration, so the duration that was requested when the
+	// certificate was issued is compared instead of its actual validity.
+	if requested, ok := secret.Annotations[v1alpha1.DurationAnnotationKey]; ok && requested != certificateDuration(crt).String() {
+		errs = append(errs, fmt.Sprintf("Duration of the certificate is not up to date: %q", requested))
+	}
+
 	return len(errs) == 0, errs
 }
 
+// updateSecretTemplate will update the labels and annotations on the given
+// secret to match the Certificate's secret template, if they do not already.
+func (c *controller) updateSecretTemplate(ctx context.Context, secret *corev1.Secret, crt *v1alpha1.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	secret = secret.DeepCopy()
+	if !applySecretTemplate(crt, secret) {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating secret metadata to match secret template")
+	_, err := c.kClient.CoreV1().Secrets(secret.Namespace).Update(secret)
+	return err
+}
+
+// applySecretTemplate copies the labels and annotations from the
+// Certificate's secret template onto the given secret. Existing metadata
+// with keys not present in the template is left untouched.
+// It returns true if the secret was modified.
+func applySecretTemplate(crt *v1alpha1.Certificate, secret *corev1.Secret) bool {
+	tmpl := crt.Spec.SecretTemplate
+	if tmpl == nil {
+		return false
+	}
+
+	changed := false
+	for k, v := range tmpl.Labels {
+		if existing, ok := secret.Labels[k]; ok && existing == v {
+			continue
+		}
+		if secret.Labels == nil {
+			secret.Labels = make(map[string]string)
+		}
+		secret.Labels[k] = v
+		changed = true
+	}
+	for k, v := range tmpl.Annotations {
+		if existing, ok := secret.Annotations[k]; ok && existing == v {
+			continue
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[k] = v
+		changed = true
+	}
+
+	return changed
+}
+
+// keystoresMatchSpec checks that the keystores stored in the given secret
+// match those requested on the Certificate and are encrypted using the
+// current keystore passwords.
+// It returns a list of reasons why the stored keystores are out of date.
+func (c *controller) keystoresMatchSpec(crt *v1alpha1.Certificate, secret *corev1.Secret) ([]string, error) {
+	var errs []string
+
+	jks, pkcs12 := keystoresForCertificate(crt)
+
+	if jks == nil {
+		for _, k := range []string{v1alpha1.JKSSecretKey, v1alpha1.JKSTruststoreKey} {
+			if _, ok := secret.Data[k]; ok {
+				errs = append(errs, fmt.Sprintf("JKS keystores are not enabled but %q exists", k))
+			}
+		}
+	} else {
+		password, err := c.keystorePassword(crt.Namespace, jks.PasswordSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		keystores := []string{v1alpha1.JKSSecretKey}
+		if len(secret.Data[TLSCAKey]) > 0 {
+			keystores = append(keystores, v1alpha1.JKSTruststoreKey)
+		}
+		for _, k := range keystores {
+			data, ok := secret.Data[k]
+			if !ok {
+				errs = append(errs, fmt.Sprintf("JKS keystore %q does not exist", k))
+				continue
+			}
+			if err := pki.VerifyJKSKeystore(data, password); err != nil {
+				errs = append(errs, fmt.Sprintf("JKS keystore %q is not up to date: %v", k, err))
+			}
+		}
+	}
+
+	if pkcs12 == nil {
+		if _, ok := secret.Data[v1alpha1.PKCS12SecretKey]; ok {
+			errs = append(errs, fmt.Sprintf("PKCS#12 keystores are not enabled but %q exists", v1alpha1.PKCS12SecretKey))
+		}
+	} else {
+		password, err := c.keystorePassword(crt.Namespace, pkcs12.PasswordSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		data, ok := secret.Data[v1alpha1.PKCS12SecretKey]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("PKCS#12 keystore %q does not exist", v1alpha1.PKCS12SecretKey))
+		} else if err := pki.VerifyPKCS12Keystore(data, string(password)); err != nil {
+			errs = append(errs, fmt.Sprintf("PKCS#12 keystore %q is not up to date: %v", v1alpha1.PKCS12SecretKey, err))
+		}
+	}
+
+	return errs, nil
+}
+
+// setKeystores will encode the certificate, private key and CA stored in the
+// given secret into each of the keystore formats requested on the Certificate.
+// Keystores that are no longer requested are removed from the secret.
+func (c *controller) setKeystores(crt *v1alpha1.Certificate, secret *corev1.Secret) error {
+	cert := secret.Data[corev1.TLSCertKey]
+	key := secret.Data[corev1.TLSPrivateKeyKey]
+	ca := secret.Data[TLSCAKey]
+
+	jks, pkcs12 := keystoresForCertificate(crt)
+
+	delete(secret.Data, v1alpha1.JKSSecretKey)
+	delete(secret.Data, v1alpha1.JKSTruststoreKey)
+	delete(secret.Data, v1alpha1.PKCS12SecretKey)
+
+	// keystores can only be created once a certificate is available
+	if len(cert) == 0 {
+		return nil
+	}
+
+	if jks != nil {
+		password, err := c.keystorePassword(crt.Namespace, jks.PasswordSecretRef)
+		if err != nil {
+			return err
+		}
+		keystore, err := pki.EncodeJKSKeystore(password, key, cert)
+		if err != nil {
+			return err
+		}
+		secret.Data[v1alpha1.JKSSecretKey] = keystore
+
+		if len(ca) > 0 {
+			truststore, err := pki.EncodeJKSTruststore(password, ca)
+			if err != nil {
+				return err
+			}
+			secret.Data[v1alpha1.JKSTruststoreKey] = truststore
+		}
+	}
+
+	if pkcs12 != nil {
+		password, err := c.keystorePassword(crt.Namespace, pkcs12.PasswordSecretRef)
+		if err != nil {
+			return err
+		}
+		keystore, err := pki.EncodePKCS12Keystore(string(password), key, cert, ca)
+		if err != nil {
+			return err
+		}
+		secret.Data[v1alpha1.PKCS12SecretKey] = keystore
+	}
+
+	return nil
+}
+
+// keystorePassword returns the keystore password stored in the Secret
+// referenced by ref in the given namespace.
+func (c *controller) keystorePassword(namespace string, ref v1alpha1.SecretKeySelector) ([]byte, error) {
+	secret, err := c.secretLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return password, nil
+}
+
+// keystoresForCertificate returns the JKS and PKCS#12 keystore configuration
+// for the given Certificate. A nil value is returned for each keystore type
+// that has not been enabled.
+func keystoresForCertificate(crt *v1alpha1.Certificate) (*v1alpha1.JKSKeystore, *v1alpha1.PKCS12Keystore) {
+	var jks *v1alpha1.JKSKeystore
+	var pkcs12 *v1alpha1.PKCS12Keystore
+	if ks := crt.Spec.Keystores; ks != nil {
+		if ks.JKS != nil && ks.JKS.Create {
+			jks = ks.JKS
+		}
+		if ks.PKCS12 != nil && ks.PKCS12.Create {
+			pkcs12 = ks.PKCS12
+		}
+	}
+	return jks, pkcs12
+}
+
 func (c *controller) scheduleRenewal(ctx context.Context, crt *v1alpha1.Certificate) {
 	log := logf.FromContext(ctx)
 	log = log.WithValues(
@@ -315,6 +793,13 @@ func (c *controller) scheduleRenewal(ctx context.Context, crt *v1alpha1.Certific
 	}
 
 	renewIn := c.calculateDurationUntilRenew(ctx, cert, crt)
+	// make sure the certificate is synced again when the renewal information
+	// suggested by the issuer needs to be refreshed
+	if info := crt.Status.RenewalInfo; info != nil {
+		if checkIn := info.NextCheckTime.Sub(c.clock.Now()); checkIn > 0 && checkIn < renewIn {
+			renewIn = checkIn
+		}
+	}
 	c.scheduledWorkQueue.Add(key, renewIn)
 
 	log.WithValues("duration_until_renewal", renewIn.String()).Info("certificate scheduled for renewal")
@@ -346,6 +831,10 @@ func ownerRef(crt *v1alpha1.Certificate) metav1.OwnerReference {
 // - If the provided certificate is a temporary certificate and the certificate
 //   stored in the secret is already a temporary certificate, then the Secret
 //   **will not** be updated.
+// - If no certificate is provided and the secret already contains a
+//   certificate for a different private key, the private key is stored as the
+//   next private key, leaving the existing certificate and private key in
+//   place until the new certificate has been issued.
 func (c *controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate, namespace string, cert, key, ca []byte) (*corev1.Secret, error) {
 	log := logf.FromContext(ctx, "updateSecret")
 	log = logf.WithRelatedResourceName(log, crt.Spec.SecretName, namespace, "Secret")
@@ -399,6 +888,22 @@ func (c *controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 		}
 	}
 
+	if len(cert) == 0 && existingCert != nil && !pki.IsTemporaryCertificate(existingCert) {
+		matches, err := pki.PublicKeyMatchesCertificate(privKey.Public(), existingCert)
+		if err != nil || !matches {
+			log.V(logf.DebugLevel).Info("storing private key as the next private key until a certificate has been issued for it")
+			secret.Data[v1alpha1.NextPrivateKeySecretKey] = key
+			return c.kClient.CoreV1().Secrets(namespace).Update(secret)
+		}
+	}
+
+	// the next private key is replaced by whatever is being stored, unless
+	// the existing certificate is only being written back (e.g. to update
+	// keystores)
+	if !bytes.Equal(cert, existingCertData) {
+		delete(secret.Data, v1alpha1.NextPrivateKeySecretKey)
+	}
+
 	var x509Cert *x509.Certificate
 	switch {
 	case len(cert) > 0:
@@ -408,7 +913,7 @@ func (c *controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 		}
 	case !utilfeature.DefaultFeatureGate.Enabled(feature.IssueTemporaryCertificate):
 		break
-	case isTemporaryCertificate(existingCert):
+	case pki.IsTemporaryCertificate(existingCert):
 		matches, err := pki.PublicKeyMatchesCertificate(privKey.Public(), existingCert)
 		if err == nil && matches {
 			// if the existing certificate is a temporary one, and the certificate
@@ -445,8 +950,21 @@ func (c *controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 		secret.Annotations[v1alpha1.CommonNameAnnotationKey] = x509Cert.Subject.CommonName
 		secret.Annotations[v1alpha1.AltNamesAnnotationKey] = strings.Join(x509Cert.DNSNames, ",")
 		secret.Annotations[v1alpha1.IPSANAnnotationKey] = strings.Join(pki.IPAddressesToString(x509Cert.IPAddresses), ",")
+		secret.Annotations[v1alpha1.URISANAnnotationKey] = strings.Join(pki.URLsToString(x509Cert.URIs), ",")
+		secret.Annotations[v1alpha1.EmailSANAnnotationKey] = strings.Join(x509Cert.EmailAddresses, ",")
+		secret.Annotations[v1alpha1.DurationAnnotationKey] = certificateDuration(crt).String()
+		// the revision is recorded alongside the certificate so that it is
+		// not lost if the Certificate's status fails to be updated
+		if crt.Status.Revision != nil {
+			secret.Annotations[v1alpha1.CertificateRequestRevisionAnnotationKey] = strconv.Itoa(*crt.Status.Revision)
+		} else {
+			delete(secret.Annotations, v1alpha1.CertificateRequestRevisionAnnotationKey)
+		}
 	}
 
+	// apply any labels and annotations configured in the secret template
+	applySecretTemplate(crt, secret)
+
 	// Always set the certificate name label on the target secret
 	secret.Labels[v1alpha1.CertificateNameKey] = crt.Name
 
@@ -455,6 +973,12 @@ func (c *controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 	secret.Data[corev1.TLSPrivateKeyKey] = key
 	secret.Data[TLSCAKey] = ca
 
+	// regenerate any keystores requested on the Certificate so that they
+	// always contain the certificate and private key being stored
+	if err := c.setKeystores(crt, secret); err != nil {
+		return nil, fmt.Errorf("error encoding keystores: %v", err)
+	}
+
 	// if it is a new resource
 	if secret.SelfLink == "" {
 		if c.addOwnerReferences {
@@ -472,12 +996,31 @@ func (c *controller) updateSecret(ctx context.Context, crt *v1alpha1.Certificate
 
 // return an error on failure. If retrieval is succesful, the certificate data
 // and private key will be stored in the named secret
-func (c *controller) issue(ctx context.Context, issuer issuer.Interface, crt *v1alpha1.Certificate) error {
+// If the CertificateRequestControllers feature gate is enabled, the
+// certificate will instead be issued by creating a CertificateRequest.
+func (c *controller) issue(ctx context.Context, issuerObj v1alpha1.GenericIssuer, issuer issuer.Interface, crt *v1alpha1.Certificate) error {
 	log := logf.FromContext(ctx)
 
+	if backoff := c.issuanceBackoff(crt); backoff > 0 {
+		log.Info("not issuing certificate as a previous issuance attempt failed", "retry_in", backoff.String())
+		c.scheduleIssuanceRetry(ctx, crt, backoff)
+		return nil
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRequestControllers) {
+		return c.issueWithCertificateRequest(ctx, issuerObj, crt)
+	}
+
 	resp, err := issuer.Issue(ctx, crt)
+	if errors.IsPending(err) {
+		// the issuer is still processing the request, so this is not
+		// treated as a failed attempt
+		log.V(logf.DebugLevel).Info("certificate issuance still pending", "reason", err.Error())
+		return err
+	}
 	if err != nil {
 		log.Error(err, "error issuing certificate")
+		c.recordIssuanceFailure(crt, err.Error())
 		return err
 	}
 	// if the issuer has not returned any data, exit early
@@ -494,6 +1037,9 @@ func (c *controller) issue(ctx context.Context, issuer issuer.Interface, crt *v1
 
 	if len(resp.Certificate) > 0 {
 		c.recorder.Event(crt, corev1.EventTypeNormal, successCertificateIssued, "Certificate issued successfully")
+		// any manually requested renewal has now been completed
+		apiutil.RemoveCertificateCondition(crt, v1alpha1.CertificateConditionRenewalRequested)
+		resetIssuanceFailures(crt)
 		// as we have just written a certificate, we should schedule it for renewal
 		c.scheduleRenewal(ctx, crt)
 	}
@@ -501,22 +1047,88 @@ func (c *controller) issue(ctx context.Context, issuer issuer.Interface, crt *v1
 	return nil
 }
 
-// staticTemporarySerialNumber is a fixed serial number we check for when
-// updating the status of a certificate.
-// It is used to identify temporarily generated certificates, so that friendly
-// status messages can be displayed to users.
-const staticTemporarySerialNumber = 0x1234567890
+// issuanceBackoff returns how long the controller should wait before
+// attempting to issue the certificate again, following one or more failed
+// issuance attempts. The back-off doubles with each consecutive failure.
+// A renewal manually requested after the last failure, or a change to the
+// certificate's spec since the last failure, bypasses the back-off.
+func (c *controller) issuanceBackoff(crt *v1alpha1.Certificate) time.Duration {
+	attempts := crt.Status.FailedIssuanceAttempts
+	lastFailure := crt.Status.LastIssuanceFailureTime
+	if attempts == nil || *attempts < 1 || lastFailure == nil {
+		return 0
+	}
 
-func isTemporaryCertificate(cert *x509.Certificate) bool {
-	if cert == nil {
-		return false
+	if !issuanceFailureGenerationMatches(crt) {
+		return 0
+	}
+
+	if apiutil.CertificateRenewalRequested(crt) && crt.Status.LastRenewalRequestTime != nil &&
+		crt.Status.LastRenewalRequestTime.After(lastFailure.Time) {
+		return 0
+	}
+
+	backoff := issuanceBackoffInitial
+	for i := 1; i < *attempts && backoff < issuanceBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > issuanceBackoffMax {
+		backoff = issuanceBackoffMax
 	}
-	return cert.SerialNumber.Int64() == staticTemporarySerialNumber
+
+	return lastFailure.Add(backoff).Sub(c.clock.Now())
+}
+
+// recordIssuanceFailure records a failed issuance attempt on the status of
+// the certificate, which will cause subsequent attempts to be backed off.
+func (c *controller) recordIssuanceFailure(crt *v1alpha1.Certificate, reason string) {
+	attempts := 1
+	if crt.Status.FailedIssuanceAttempts != nil && issuanceFailureGenerationMatches(crt) {
+		attempts = *crt.Status.FailedIssuanceAttempts + 1
+	}
+
+	nowTime := metav1.NewTime(c.clock.Now())
+	generation := crt.Generation
+	crt.Status.LastIssuanceFailureTime = &nowTime
+	crt.Status.LastIssuanceFailureGeneration = &generation
+	crt.Status.FailedIssuanceAttempts = &attempts
+
+	c.recorder.Eventf(crt, corev1.EventTypeWarning, errorIssueFailed, "Issuance attempt %d failed, retrying in %s: %s", attempts, c.issuanceBackoff(crt), reason)
+}
+
+// resetIssuanceFailures clears any failed issuance attempts recorded on the
+// status of the certificate.
+func resetIssuanceFailures(crt *v1alpha1.Certificate) {
+	crt.Status.LastIssuanceFailureTime = nil
+	crt.Status.LastIssuanceFailureGeneration = nil
+	crt.Status.FailedIssuanceAttempts = nil
+}
+
+// issuanceFailureGenerationMatches returns true if the failed issuance
+// attempts recorded on the status of the certificate were made for its
+// current generation.
+func issuanceFailureGenerationMatches(crt *v1alpha1.Certificate) bool {
+	generation := crt.Status.LastIssuanceFailureGeneration
+	return generation == nil || *generation == crt.Generation
+}
+
+// scheduleIssuanceRetry schedules the certificate to be re-synced once its
+// issuance back-off has elapsed.
+func (c *controller) scheduleIssuanceRetry(ctx context.Context, crt *v1alpha1.Certificate, backoff time.Duration) {
+	log := logf.FromContext(ctx)
+
+	key, err := keyFunc(crt)
+	if err != nil {
+		log.Error(err, "error getting key for certificate resource")
+		return
+	}
+
+	c.scheduledWorkQueue.Add(key, backoff)
 }
 
 func generateSelfSignedTemporaryCertificate(crt *v1alpha1.Certificate, pk []byte) ([]byte, error) {
 	template, err := pki.GenerateTemplate(crt)
-	template.SerialNumber = big.NewInt(staticTemporarySerialNumber)
+	template.SerialNumber = big.NewInt(pki.TemporaryCertificateSerialNumber)
 
 	signer, err := pki.DecodePrivateKeyBytes(pk)
 	if err != nil {
@@ -562,7 +1174,7 @@ func generateLocallySignedTemporaryCertificate(crt *v1alpha1.Certificate, pk []b
 	if err != nil {
 		return nil, err
 	}
-	template.SerialNumber = big.NewInt(staticTemporarySerialNumber)
+	template.SerialNumber = big.NewInt(pki.TemporaryCertificateSerialNumber)
 
 	signeeKey, err := pki.DecodePrivateKeyBytes(pk)
 	if err != nil {
@@ -585,8 +1197,22 @@ func (c *controller) updateCertificateStatus(ctx context.Context, old, new *v1al
 		return nil, nil
 	}
 	log.V(logf.DebugLevel).Info("updating resource due to change in status", "diff", pretty.Diff(string(oldBytes), string(newBytes)))
-	// TODO: replace Update call with UpdateStatus. This requires a custom API
-	// server with the /status subresource enabled and/or subresource support
-	// for CRDs (https://github.com/kubernetes/kubernetes/issues/38113)
-	return c.cmClient.CertmanagerV1alpha1().Certificates(new.Namespace).Update(new)
+	var updated *v1alpha1.Certificate
+	err := controllerpkg.RetryStatusUpdate(func() (err error) {
+		updated, err = c.cmClient.CertmanagerV1alpha1().Certificates(new.Namespace).UpdateStatus(new)
+		return err
+	}, func() (bool, error) {
+		latest, err := c.cmClient.CertmanagerV1alpha1().Certificates(new.Namespace).Get(new.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(latest.Status, old.Status) {
+			return false, nil
+		}
+		status := new.Status
+		new = latest.DeepCopy()
+		new.Status = status
+		return true, nil
+	})
+	return updated, err
 }
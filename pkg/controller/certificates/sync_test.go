@@ -25,6 +25,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -40,6 +41,7 @@ import (
 	"github.com/leki75/cert-manager/pkg/issuer"
 	"github.com/leki75/cert-manager/pkg/issuer/fake"
 	_ "github.com/leki75/cert-manager/pkg/issuer/selfsigned"
+	"github.com/leki75/cert-manager/pkg/util/errors"
 	"github.com/leki75/cert-manager/pkg/util/pki"
 	"github.com/leki75/cert-manager/test/unit/gen"
 )
@@ -108,6 +110,14 @@ func TestSync(t *testing.T) {
 		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
 		gen.SetCertificateSecretName("output"),
 	)
+	exampleCertWithClusterIssuer := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test", Kind: cmapi.ClusterIssuerKind}),
+	)
+	exampleCertWithSubject := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateSubject(cmapi.X509Subject{
+			OrganizationalUnits: []string{"Engineering"},
+		}),
+	)
 	exampleCertNotFoundCondition := gen.CertificateFrom(exampleCert,
 		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 			Type:               cmapi.CertificateConditionReady,
@@ -136,6 +146,18 @@ func TestSync(t *testing.T) {
 		t.FailNow()
 	}
 
+	cert1RenewedPEM := generateSelfSignedCert(t, exampleCert, nil, pk1, nowTime, nowTime.Add(time.Hour*12))
+	exampleCertRenewalRequested := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateLastRenewalRequestTime(nowMetaTime),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionRenewalRequested,
+			Status:             cmapi.ConditionTrue,
+			Reason:             "ManuallyTriggered",
+			Message:            "Certificate renewal manually requested",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+
 	pk2 := generatePrivateKey(t)
 	// pk2PEM := pki.EncodePKCS1PrivateKey(pk2)
 	cert2PEM := generateSelfSignedCert(t, exampleCert, nil, pk2, nowTime, nowTime.Add(time.Hour*24))
@@ -145,7 +167,33 @@ func TestSync(t *testing.T) {
 		t.FailNow()
 	}
 
-	localTempCert := generateSelfSignedCert(t, exampleCert, big.NewInt(staticTemporarySerialNumber), pk1, nowTime, nowTime)
+	exampleCertWithKeystores := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateKeystores(cmapi.CertificateKeystores{
+			PKCS12: &cmapi.PKCS12Keystore{
+				Create: true,
+				PasswordSecretRef: cmapi.SecretKeySelector{
+					LocalObjectReference: cmapi.LocalObjectReference{Name: "keystore-password"},
+					Key:                  "password",
+				},
+			},
+		}),
+	)
+	exampleCertWithECDSAKey := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+	)
+	exampleCertWithSecretTemplate := gen.CertificateFrom(exampleCert,
+		gen.SetCertificateSecretTemplate(
+			map[string]string{"app.kubernetes.io/part-of": "example"},
+			map[string]string{"replicator.v1.mittwald.de/replicate-to": "other"},
+		),
+	)
+	pk1OldPKCS12, err := pki.EncodePKCS12Keystore("old-password", pk1PEM, cert1PEM, nil)
+	if err != nil {
+		t.Errorf("Error encoding test PKCS#12 keystore: %v", err)
+		t.FailNow()
+	}
+
+	localTempCert := generateSelfSignedCert(t, exampleCert, big.NewInt(pki.TemporaryCertificateSerialNumber), pk1, nowTime, nowTime)
 
 	tests := map[string]controllerFixture{
 		"should update certificate with NotExists if issuer does not return a keypair": {
@@ -168,8 +216,9 @@ func TestSync(t *testing.T) {
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCertNotFoundCondition,
 					)),
@@ -179,6 +228,217 @@ func TestSync(t *testing.T) {
 			},
 			Err: false,
 		},
+		"should mark the certificate as not ready if it violates a CertificatePolicy": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("issue should not be called")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.Certificate("test"),
+					&cmapi.CertificatePolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+						Spec: cmapi.CertificatePolicySpec{
+							AllowedDNSNames: []string{"*.example.org"},
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "PolicyViolation",
+								Message:            `Certificate does not satisfy CertificatePolicy: spec.dnsNames: Forbidden: DNS name "example.com" is not permitted by CertificatePolicy "restricted"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			Err: false,
+		},
+		"should mark the certificate as not ready if the clusterissuer does not permit its namespace": {
+			Issuer: gen.ClusterIssuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+				gen.SetIssuerNamespaceSelector(cmapi.IssuerNamespaceSelector{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+				}),
+			),
+			Certificate: *exampleCertWithClusterIssuer,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("issue should not be called")
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: gen.DefaultTestNamespace}},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertWithClusterIssuer,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "NotPermitted",
+								Message:            `ClusterIssuer "test" does not permit Certificates in namespace "default-unit-test-ns"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			Err: false,
+		},
+		"should record a failed issuance attempt if the issuer returns an error": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("issuer unavailable")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertNotFoundCondition,
+							gen.SetCertificateLastIssuanceFailureTime(nowMetaTime),
+							gen.SetCertificateLastIssuanceFailureGeneration(0),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+				},
+			},
+			Err: true,
+		},
+		"should not call the issuer while issuance is being backed off": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *gen.CertificateFrom(exampleCert,
+				gen.SetCertificateLastIssuanceFailureTime(metav1.NewTime(nowTime.Add(-time.Minute*5))),
+				gen.SetCertificateFailedIssuanceAttempts(2),
+			),
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("issuer should not be called")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertNotFoundCondition,
+							gen.SetCertificateLastIssuanceFailureTime(metav1.NewTime(nowTime.Add(-time.Minute*5))),
+							gen.SetCertificateFailedIssuanceAttempts(2),
+						),
+					)),
+				},
+			},
+		},
+		"should not record a failed issuance attempt if the issuer is still pending": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, errors.NewPending("certificate still pending")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						exampleCertNotFoundCondition,
+					)),
+				},
+			},
+			Err: true,
+		},
+		"should reset the issuance back-off if the spec has changed since the last failure": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *gen.CertificateFrom(exampleCert,
+				gen.SetCertificateGeneration(2),
+				gen.SetCertificateLastIssuanceFailureTime(metav1.NewTime(nowTime.Add(-time.Minute*5))),
+				gen.SetCertificateLastIssuanceFailureGeneration(1),
+				gen.SetCertificateFailedIssuanceAttempts(2),
+			),
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("issuer unavailable")
+				},
+			},
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertNotFoundCondition,
+							gen.SetCertificateGeneration(2),
+							gen.SetCertificateLastIssuanceFailureTime(nowMetaTime),
+							gen.SetCertificateLastIssuanceFailureGeneration(2),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+						),
+					)),
+				},
+			},
+			Err: true,
+		},
 		"should create a secret containing the private key only when one doesn't exist": {
 			Issuer: gen.Issuer("test",
 				gen.AddIssuerCondition(cmapi.IssuerCondition{
@@ -199,8 +459,9 @@ func TestSync(t *testing.T) {
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCertNotFoundCondition,
 					)),
@@ -218,8 +479,11 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Type: corev1.SecretTypeTLS,
@@ -268,8 +532,9 @@ func TestSync(t *testing.T) {
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCertNotFoundCondition,
 					)),
@@ -289,8 +554,11 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
@@ -323,8 +591,9 @@ func TestSync(t *testing.T) {
 			Builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCertNotFoundCondition,
 					)),
@@ -342,8 +611,11 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
@@ -392,8 +664,9 @@ func TestSync(t *testing.T) {
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCertNotFoundCondition,
 					)),
@@ -413,8 +686,11 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
@@ -470,8 +746,9 @@ func TestSync(t *testing.T) {
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateFrom(exampleCert,
 							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
@@ -482,6 +759,8 @@ func TestSync(t *testing.T) {
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateNotAfter(metav1.NewTime(cert2.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert2.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert2.NotAfter)),
 						),
 					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
@@ -500,8 +779,11 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
@@ -546,6 +828,8 @@ func TestSync(t *testing.T) {
 								"certmanager.k8s.io/alt-names":   "example.com",
 								"certmanager.k8s.io/common-name": "example.com",
 								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
+								"certmanager.k8s.io/email-sans":  "",
 								"certmanager.k8s.io/issuer-kind": "Issuer",
 								"certmanager.k8s.io/issuer-name": "test",
 							},
@@ -559,8 +843,9 @@ func TestSync(t *testing.T) {
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateFrom(exampleCert,
 							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
@@ -571,12 +856,14 @@ func TestSync(t *testing.T) {
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
 						),
 					)),
 				},
 			},
 		},
-		"should update the reason field with temporary self signed cert text": {
+		"should re-issue certificate with a manually requested renewal": {
 			Issuer: gen.Issuer("test",
 				gen.AddIssuerCondition(cmapi.IssuerCondition{
 					Type:   cmapi.IssuerConditionReady,
@@ -584,18 +871,15 @@ func TestSync(t *testing.T) {
 				}),
 				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
 			),
-			Certificate: *exampleCert,
+			Certificate: *exampleCertRenewalRequested,
 			IssuerImpl: &fake.Issuer{
 				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
 					return &issuer.IssueResponse{
-						PrivateKey: pk1PEM,
+						PrivateKey:  pk1PEM,
+						Certificate: cert1RenewedPEM,
 					}, nil
 				},
 			},
-			// set this to something other than localTempCert, so that we can
-			// assert that the controller doesn't enter in a loop updating the
-			// Secret resource with a newly generated certificate
-			StaticTemporaryCert: cert1PEM,
 			Builder: &testpkg.Builder{
 				KubeObjects: []runtime.Object{
 					&corev1.Secret{
@@ -604,14 +888,21 @@ func TestSync(t *testing.T) {
 							Name:      "output",
 							SelfLink:  "abc",
 							Labels: map[string]string{
-								cmapi.CertificateNameKey: "nottest",
+								cmapi.CertificateNameKey: "test",
 							},
 							Annotations: map[string]string{
-								"testannotation": "true",
+								"certmanager.k8s.io/alt-names":   "example.com",
+								"certmanager.k8s.io/common-name": "example.com",
+								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
+								"certmanager.k8s.io/email-sans":  "",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+								"certmanager.k8s.io/duration":    "2160h0m0s",
 							},
 						},
 						Data: map[string][]byte{
-							corev1.TLSCertKey:       localTempCert,
+							corev1.TLSCertKey:       cert1PEM,
 							corev1.TLSPrivateKeyKey: pk1PEM,
 							TLSCAKey:                nil,
 						},
@@ -619,6 +910,24 @@ func TestSync(t *testing.T) {
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateLastRenewalRequestTime(nowMetaTime),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+						),
+					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						corev1.SchemeGroupVersion.WithResource("secrets"),
 						gen.DefaultTestNamespace,
@@ -631,30 +940,27 @@ func TestSync(t *testing.T) {
 									cmapi.CertificateNameKey: "test",
 								},
 								Annotations: map[string]string{
-									"testannotation":                 "true",
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
-								corev1.TLSCertKey:       localTempCert,
+								corev1.TLSCertKey:       cert1RenewedPEM,
 								corev1.TLSPrivateKeyKey: pk1PEM,
 								TLSCAKey:                nil,
 							},
 						},
 					)),
-					testpkg.NewAction(coretesting.NewUpdateAction(
-						cmapi.SchemeGroupVersion.WithResource("certificates"),
-						gen.DefaultTestNamespace,
-						exampleCertTemporaryCondition,
-					)),
 				},
 			},
 		},
-		"should mark certificate with wrong issuer name as DoesNotMatch": {
+		"should update keystores in secret if the keystore password has changed": {
 			Issuer: gen.Issuer("test",
 				gen.AddIssuerCondition(cmapi.IssuerCondition{
 					Type:   cmapi.IssuerConditionReady,
@@ -662,17 +968,23 @@ func TestSync(t *testing.T) {
 				}),
 				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
 			),
-			Certificate: *exampleCert,
+			Certificate: *exampleCertWithKeystores,
 			IssuerImpl: &fake.Issuer{
 				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
-					return &issuer.IssueResponse{
-						PrivateKey:  pk1PEM,
-						Certificate: cert1PEM,
-					}, nil
+					return nil, fmt.Errorf("unexpected call to Issue")
 				},
 			},
 			Builder: &testpkg.Builder{
 				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "keystore-password",
+						},
+						Data: map[string][]byte{
+							"password": []byte("new-password"),
+						},
+					},
 					&corev1.Secret{
 						ObjectMeta: metav1.ObjectMeta{
 							Namespace: gen.DefaultTestNamespace,
@@ -682,52 +994,605 @@ func TestSync(t *testing.T) {
 								cmapi.CertificateNameKey: "test",
 							},
 							Annotations: map[string]string{
-								"testannotation":                 "true",
+								"certmanager.k8s.io/alt-names":   "example.com",
+								"certmanager.k8s.io/common-name": "example.com",
+								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
+								"certmanager.k8s.io/email-sans":  "",
 								"certmanager.k8s.io/issuer-kind": "Issuer",
-								"certmanager.k8s.io/issuer-name": "not-test",
+								"certmanager.k8s.io/issuer-name": "test",
 							},
 						},
 						Data: map[string][]byte{
 							corev1.TLSCertKey:       cert1PEM,
 							corev1.TLSPrivateKeyKey: pk1PEM,
 							TLSCAKey:                nil,
+							cmapi.PKCS12SecretKey:   pk1OldPKCS12,
 						},
 					},
 				},
 				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
-						gen.CertificateFrom(exampleCert,
+						gen.CertificateFrom(exampleCertWithKeystores,
 							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 								Type:               cmapi.CertificateConditionReady,
-								Status:             cmapi.ConditionFalse,
-								Reason:             "DoesNotMatch",
-								Message:            "Issuer of the certificate is not up to date: \"not-test\"",
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
 						),
 					)),
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewCustomMatch(coretesting.NewUpdateAction(
 						corev1.SchemeGroupVersion.WithResource("secrets"),
 						gen.DefaultTestNamespace,
-						&corev1.Secret{
-							ObjectMeta: metav1.ObjectMeta{
-								Namespace: gen.DefaultTestNamespace,
-								Name:      "output",
-								SelfLink:  "abc",
-								Labels: map[string]string{
-									cmapi.CertificateNameKey: "test",
-								},
-								Annotations: map[string]string{
-									"testannotation":                 "true",
-									"certmanager.k8s.io/alt-names":   "example.com",
-									"certmanager.k8s.io/common-name": "example.com",
-									"certmanager.k8s.io/ip-sans":     "",
-									"certmanager.k8s.io/issuer-kind": "Issuer",
-									"certmanager.k8s.io/issuer-name": "test",
+						nil,
+					), func(exp, actual coretesting.Action) error {
+						secret := actual.(coretesting.UpdateAction).GetObject().(*corev1.Secret)
+						if secret.Name != "output" {
+							return fmt.Errorf("unexpected secret updated: %q", secret.Name)
+						}
+						if !bytes.Equal(secret.Data[corev1.TLSCertKey], cert1PEM) || !bytes.Equal(secret.Data[corev1.TLSPrivateKeyKey], pk1PEM) {
+							return fmt.Errorf("expected certificate and private key to be unchanged")
+						}
+						return pki.VerifyPKCS12Keystore(secret.Data[cmapi.PKCS12SecretKey], "new-password")
+					}),
+				},
+			},
+		},
+		"should apply the secret template to the secret of an up to date certificate": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertWithSecretTemplate,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return nil, fmt.Errorf("unexpected call to Issue")
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey:    "test",
+								"app.kubernetes.io/part-of": "outdated",
+								"other-controller":          "true",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/alt-names":   "example.com",
+								"certmanager.k8s.io/common-name": "example.com",
+								"certmanager.k8s.io/ip-sans":     "",
+								"certmanager.k8s.io/uri-sans":    "",
+								"certmanager.k8s.io/email-sans":  "",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertWithSecretTemplate,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionTrue,
+								Reason:             "Ready",
+								Message:            "Certificate is up to date and has not expired",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey:    "test",
+									"app.kubernetes.io/part-of": "example",
+									"other-controller":          "true",
+								},
+								Annotations: map[string]string{
+									"testannotation":                         "true",
+									"certmanager.k8s.io/alt-names":           "example.com",
+									"certmanager.k8s.io/common-name":         "example.com",
+									"certmanager.k8s.io/ip-sans":             "",
+									"certmanager.k8s.io/uri-sans":            "",
+									"certmanager.k8s.io/email-sans":          "",
+									"certmanager.k8s.io/issuer-kind":         "Issuer",
+									"certmanager.k8s.io/issuer-name":         "test",
+									"replicator.v1.mittwald.de/replicate-to": "other",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		"should update the reason field with temporary self signed cert text": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey: pk1PEM,
+					}, nil
+				},
+			},
+			// set this to something other than localTempCert, so that we can
+			// assert that the controller doesn't enter in a loop updating the
+			// Secret resource with a newly generated certificate
+			StaticTemporaryCert: cert1PEM,
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "nottest",
+							},
+							Annotations: map[string]string{
+								"testannotation": "true",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       localTempCert,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       localTempCert,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						exampleCertTemporaryCondition,
+					)),
+				},
+			},
+		},
+		"should mark certificate with wrong issuer name as DoesNotMatch": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "not-test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{gen.Certificate("test")},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            "Issuer of the certificate is not up to date: \"not-test\"",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		"should mark certificate with outdated subject as DoesNotMatch": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertWithSubject,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{exampleCertWithSubject},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertWithSubject,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            "Organizational units on TLS certificate not up to date: []",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+		},
+		"should re-issue certificate with outdated key algorithm": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCertWithECDSAKey,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{exampleCertWithECDSAKey},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCertWithECDSAKey,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            `Private key algorithm not up to date: "rsa"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       cert1PEM,
+								corev1.TLSPrivateKeyKey: pk1PEM,
+								TLSCAKey:                nil,
+							},
+						},
+					)),
+				},
+			},
+			CheckFn: func(t *testing.T, s *controllerFixture, args ...interface{}) {
+				expected := `Normal DoesNotMatch Re-issuing certificate as it does not match its spec: Private key algorithm not up to date: "rsa"`
+				for _, e := range s.Builder.Events() {
+					if e == expected {
+						return
+					}
+				}
+				t.Errorf("expected event %q to be recorded, got: %v", expected, s.Builder.Events())
+			},
+		},
+		"should mark certificate with outdated duration as DoesNotMatch": {
+			Issuer: gen.Issuer("test",
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:   cmapi.IssuerConditionReady,
+					Status: cmapi.ConditionTrue,
+				}),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			Certificate: *exampleCert,
+			IssuerImpl: &fake.Issuer{
+				FakeIssue: func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						PrivateKey:  pk1PEM,
+						Certificate: cert1PEM,
+					}, nil
+				},
+			},
+			Builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							SelfLink:  "abc",
+							Labels: map[string]string{
+								cmapi.CertificateNameKey: "test",
+							},
+							Annotations: map[string]string{
+								"testannotation":                 "true",
+								"certmanager.k8s.io/issuer-kind": "Issuer",
+								"certmanager.k8s.io/issuer-name": "test",
+								"certmanager.k8s.io/duration":    "720h0m0s",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert1PEM,
+							corev1.TLSPrivateKeyKey: pk1PEM,
+							TLSCAKey:                nil,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{exampleCert},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateFrom(exampleCert,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionReady,
+								Status:             cmapi.ConditionFalse,
+								Reason:             "DoesNotMatch",
+								Message:            `Duration of the certificate is not up to date: "720h0m0s"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateNotAfter(metav1.NewTime(cert1.NotAfter)),
+							gen.SetCertificateNotBefore(metav1.NewTime(cert1.NotBefore)),
+							gen.SetCertificateRenewalTime(metav1.NewTime(cert1.NotAfter)),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								SelfLink:  "abc",
+								Labels: map[string]string{
+									cmapi.CertificateNameKey: "test",
+								},
+								Annotations: map[string]string{
+									"testannotation":                 "true",
+									"certmanager.k8s.io/alt-names":   "example.com",
+									"certmanager.k8s.io/common-name": "example.com",
+									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
+									"certmanager.k8s.io/issuer-kind": "Issuer",
+									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
@@ -767,8 +1632,9 @@ func TestSync(t *testing.T) {
 					),
 				},
 				ExpectedActions: []testpkg.Action{
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateFrom(exampleCert,
 							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
@@ -808,8 +1674,9 @@ func TestSync(t *testing.T) {
 				},
 				ExpectedActions: []testpkg.Action{
 					// specifically tests that a secret is created - behaves as usual
-					testpkg.NewAction(coretesting.NewUpdateAction(
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
 						gen.DefaultTestNamespace,
 						exampleCertNotFoundCondition,
 					)),
@@ -827,8 +1694,11 @@ func TestSync(t *testing.T) {
 									"certmanager.k8s.io/alt-names":   "example.com",
 									"certmanager.k8s.io/common-name": "example.com",
 									"certmanager.k8s.io/ip-sans":     "",
+									"certmanager.k8s.io/uri-sans":    "",
+									"certmanager.k8s.io/email-sans":  "",
 									"certmanager.k8s.io/issuer-kind": "Issuer",
 									"certmanager.k8s.io/issuer-name": "test",
+									"certmanager.k8s.io/duration":    "2160h0m0s",
 								},
 							},
 							Data: map[string][]byte{
@@ -945,3 +1815,292 @@ func TestSync(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateMatchesSpecForIssuer(t *testing.T) {
+	nowTime := time.Now()
+
+	exampleCertWithSubject := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateSubject(cmapi.X509Subject{
+			OrganizationalUnits: []string{"Engineering"},
+		}),
+	)
+
+	pk := generatePrivateKey(t)
+	certPEM := generateSelfSignedCert(t, exampleCertWithSubject, nil, pk, nowTime, nowTime.Add(time.Hour*12))
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatalf("Error decoding test cert bytes: %v", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "output",
+			Annotations: map[string]string{
+				"certmanager.k8s.io/issuer-kind": "Issuer",
+				"certmanager.k8s.io/issuer-name": "test",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: pki.EncodePKCS1PrivateKey(pk),
+		},
+	}
+
+	exampleCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+	)
+	// the example certificate is self signed, so it is its own CA
+	currentCASecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "current-ca"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: certPEM,
+		},
+	}
+	rotatedCAKey := generatePrivateKey(t)
+	rotatedCASecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "rotated-ca"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: generateSelfSignedCert(t, exampleCert, nil, rotatedCAKey, nowTime, nowTime.Add(time.Hour*12)),
+		},
+	}
+
+	ecdsaCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+		gen.SetCertificateKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment),
+	)
+	ecdsaKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatalf("Error generating ecdsa key: %v", err)
+	}
+	// ACME CAs only set the digital signature usage for ECDSA keys
+	ecdsaTemplate := &x509.Certificate{
+		Version:      3,
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    nowTime,
+		NotAfter:     nowTime.Add(time.Hour * 12),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		DNSNames:     []string{"example.com"},
+	}
+	ecdsaDER, err := x509.CreateCertificate(rand.Reader, ecdsaTemplate, ecdsaTemplate, ecdsaKey.Public(), ecdsaKey)
+	if err != nil {
+		t.Fatalf("Error signing ecdsa cert: %v", err)
+	}
+	ecdsaX509Cert, err := x509.ParseCertificate(ecdsaDER)
+	if err != nil {
+		t.Fatalf("Error parsing ecdsa cert: %v", err)
+	}
+
+	// a certificate missing the key encipherment usage requested by default
+	signingOnlyTemplate := &x509.Certificate{
+		Version:      3,
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    nowTime,
+		NotAfter:     nowTime.Add(time.Hour * 12),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		DNSNames:     []string{"example.com"},
+	}
+	signingOnlyDER, err := x509.CreateCertificate(rand.Reader, signingOnlyTemplate, signingOnlyTemplate, pk.Public(), pk)
+	if err != nil {
+		t.Fatalf("Error signing cert: %v", err)
+	}
+	signingOnlyX509Cert, err := x509.ParseCertificate(signingOnlyDER)
+	if err != nil {
+		t.Fatalf("Error parsing cert: %v", err)
+	}
+
+	tests := map[string]struct {
+		issuer      cmapi.GenericIssuer
+		crt         *cmapi.Certificate
+		key         crypto.Signer
+		cert        *x509.Certificate
+		expectMatch bool
+	}{
+		"should not match a self signed certificate missing the requested subject": {
+			issuer: gen.Issuer("test", gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+			crt:    exampleCertWithSubject,
+		},
+		"should not match a venafi certificate missing the requested subject": {
+			issuer: gen.Issuer("test", gen.SetIssuerVenafi(cmapi.VenafiIssuer{})),
+			crt:    exampleCertWithSubject,
+		},
+		"should not match a venafi certificate missing the requested organization": {
+			issuer: gen.Issuer("test", gen.SetIssuerVenafi(cmapi.VenafiIssuer{})),
+			crt: gen.CertificateFrom(exampleCert,
+				gen.SetCertificateOrganization("Example Org"),
+			),
+		},
+		"should match an acme certificate without the requested organization": {
+			issuer: gen.Issuer("test", gen.SetIssuerACME(cmapi.ACMEIssuer{})),
+			crt: gen.CertificateFrom(exampleCert,
+				gen.SetCertificateOrganization("Example Org"),
+			),
+			expectMatch: true,
+		},
+		"should match a vault certificate whose subject was overridden by the role": {
+			issuer:      gen.Issuer("test", gen.SetIssuerVault(cmapi.VaultIssuer{})),
+			crt:         exampleCertWithSubject,
+			expectMatch: true,
+		},
+		"should match a certificate signed by the current CA of the issuer": {
+			issuer:      gen.Issuer("test", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "current-ca"})),
+			crt:         exampleCert,
+			expectMatch: true,
+		},
+		"should not match a certificate signed by a CA the issuer no longer uses": {
+			issuer: gen.Issuer("test", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "rotated-ca"})),
+			crt:    exampleCert,
+		},
+		"should match a ca certificate if the CA of the issuer cannot be found": {
+			issuer:      gen.Issuer("test", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "missing-ca"})),
+			crt:         exampleCert,
+			expectMatch: true,
+		},
+		"should match an ecdsa acme certificate without the key encipherment usage": {
+			issuer:      gen.Issuer("test", gen.SetIssuerACME(cmapi.ACMEIssuer{})),
+			crt:         ecdsaCert,
+			key:         ecdsaKey,
+			cert:        ecdsaX509Cert,
+			expectMatch: true,
+		},
+		"should match an ecdsa acme certificate without the key encipherment usage if no usages are requested": {
+			issuer: gen.Issuer("test", gen.SetIssuerACME(cmapi.ACMEIssuer{})),
+			crt: gen.CertificateFrom(ecdsaCert,
+				gen.SetCertificateKeyUsages(),
+			),
+			key:         ecdsaKey,
+			cert:        ecdsaX509Cert,
+			expectMatch: true,
+		},
+		"should not match a certificate without a default usage if no usages are requested": {
+			issuer: gen.Issuer("test", gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+			crt:    exampleCert,
+			key:    pk,
+			cert:   signingOnlyX509Cert,
+		},
+		"should not match an ecdsa certificate without a requested usage": {
+			issuer: gen.Issuer("test", gen.SetIssuerACME(cmapi.ACMEIssuer{})),
+			crt: gen.CertificateFrom(ecdsaCert,
+				gen.SetCertificateKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageServerAuth),
+			),
+			key:  ecdsaKey,
+			cert: ecdsaX509Cert,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &controllerFixture{
+				Issuer: test.issuer,
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{secret, currentCASecret, rotatedCASecret},
+				},
+			}
+			f.Setup(t)
+			defer f.Builder.Stop()
+
+			key, x509Cert := test.key, test.cert
+			if key == nil {
+				key, x509Cert = pk, cert
+			}
+			matches, errs := f.Controller.certificateMatchesSpec(test.crt, key, x509Cert)
+			if matches != test.expectMatch {
+				t.Errorf("expected match=%t but got %t: %v", test.expectMatch, matches, errs)
+			}
+		})
+	}
+}
+
+func TestUpdateSecretNextPrivateKey(t *testing.T) {
+	nowTime := time.Now()
+
+	exampleCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmapi.ObjectReference{Name: "test"}),
+		gen.SetCertificateSecretName("output"),
+	)
+
+	pk1 := generatePrivateKey(t)
+	pk1PEM := pki.EncodePKCS1PrivateKey(pk1)
+	cert1PEM := generateSelfSignedCert(t, exampleCert, nil, pk1, nowTime, nowTime.Add(time.Hour*12))
+	pk2 := generatePrivateKey(t)
+	pk2PEM := pki.EncodePKCS1PrivateKey(pk2)
+	cert2PEM := generateSelfSignedCert(t, exampleCert, nil, pk2, nowTime, nowTime.Add(time.Hour*12))
+
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gen.DefaultTestNamespace,
+				Name:      "output",
+				SelfLink:  "abc",
+			},
+			Data: data,
+		}
+	}
+
+	tests := map[string]struct {
+		existing   *corev1.Secret
+		cert, key  []byte
+		expectData map[string][]byte
+	}{
+		"should store a new private key as the next private key if a certificate already exists": {
+			existing: secret(map[string][]byte{
+				corev1.TLSCertKey:       cert1PEM,
+				corev1.TLSPrivateKeyKey: pk1PEM,
+			}),
+			key: pk2PEM,
+			expectData: map[string][]byte{
+				corev1.TLSCertKey:             cert1PEM,
+				corev1.TLSPrivateKeyKey:       pk1PEM,
+				cmapi.NextPrivateKeySecretKey: pk2PEM,
+			},
+		},
+		"should replace the certificate and private key and remove the next private key once issued": {
+			existing: secret(map[string][]byte{
+				corev1.TLSCertKey:             cert1PEM,
+				corev1.TLSPrivateKeyKey:       pk1PEM,
+				cmapi.NextPrivateKeySecretKey: pk2PEM,
+			}),
+			cert: cert2PEM,
+			key:  pk2PEM,
+			expectData: map[string][]byte{
+				corev1.TLSCertKey:       cert2PEM,
+				corev1.TLSPrivateKeyKey: pk2PEM,
+				TLSCAKey:                nil,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &controllerFixture{
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{test.existing},
+				},
+			}
+			f.Setup(t)
+			defer f.Builder.Stop()
+
+			updated, err := f.Controller.updateSecret(context.Background(), exampleCert, gen.DefaultTestNamespace, test.cert, test.key, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(updated.Data) != len(test.expectData) {
+				t.Errorf("expected secret data keys %v but got %v", len(test.expectData), len(updated.Data))
+			}
+			for k, v := range test.expectData {
+				if !bytes.Equal(updated.Data[k], v) {
+					t.Errorf("unexpected data for key %q in secret", k)
+				}
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1alpha1.Cluste
 			continue
 		}
 		if (iss.Spec.ACME != nil && iss.Spec.ACME.PrivateKey.Name == secret.Name) ||
+			(iss.Spec.ACME != nil && iss.Spec.ACME.ExternalAccountBinding != nil && iss.Spec.ACME.ExternalAccountBinding.Key.Name == secret.Name) ||
 			(iss.Spec.CA != nil && iss.Spec.CA.SecretName == secret.Name) ||
 			(iss.Spec.Vault != nil && iss.Spec.Vault.Auth.TokenSecretRef.Name == secret.Name) {
 			affected = append(affected, iss)
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"crypto/x509"
+	"hash/fnv"
 	"time"
 
 	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -53,7 +54,13 @@ var now = time.Now
 // CalculateDurationUntilRenew calculates how long cert-manager should wait to
 // until attempting to renew this certificate resource.
 func (o IssuerOptions) CalculateDurationUntilRenew(ctx context.Context, cert *x509.Certificate, crt *cmapi.Certificate) time.Duration {
-	log := logs.FromContext(ctx, "CalculateDurationUntilRenew")
+	return o.CalculateRenewalTime(ctx, cert, crt).Sub(now())
+}
+
+// CalculateRenewalTime calculates the time at which cert-manager should begin
+// attempting to renew this certificate resource.
+func (o IssuerOptions) CalculateRenewalTime(ctx context.Context, cert *x509.Certificate, crt *cmapi.Certificate) time.Time {
+	log := logs.FromContext(ctx, "CalculateRenewalTime")
 
 	// validate if the certificate received was with the issuer configured
 	// duration. If not we generate an event to warn the user of that fact.
@@ -80,10 +87,37 @@ func (o IssuerOptions) CalculateDurationUntilRenew(ctx context.Context, cert *x5
 		renewBefore = certDuration / 3
 	}
 
-	// calculate the amount of time until expiry
-	durationUntilExpiry := cert.NotAfter.Sub(now())
-	// calculate how long until we should start attempting to renew the certificate
-	renewIn := durationUntilExpiry - renewBefore
+	// calculate when we should start attempting to renew the certificate
+	renewalTime := cert.NotAfter.Add(-renewBefore)
+
+	// if the issuer has suggested an earlier renewal window for this
+	// certificate, for example ahead of a mass revocation, renew within it.
+	if suggested, ok := suggestedRenewalTime(cert, crt); ok && suggested.Before(renewalTime) {
+		log.V(logs.DebugLevel).Info("using renewal time suggested by issuer", "renewal_time", suggested)
+		renewalTime = suggested
+	}
+
+	return renewalTime
+}
+
+// suggestedRenewalTime returns a time within the renewal window suggested by
+// the issuer for the given certificate, if one has been recorded.
+// The time is derived from the certificate's serial number so that it is
+// stable across calls while still spreading renewals of many certificates
+// across the window.
+func suggestedRenewalTime(cert *x509.Certificate, crt *cmapi.Certificate) (time.Time, bool) {
+	info := crt.Status.RenewalInfo
+	if info == nil || cert.SerialNumber == nil || info.SerialNumber != cert.SerialNumber.Text(16) {
+		return time.Time{}, false
+	}
+
+	start := info.SuggestedWindowStart.Time
+	window := info.SuggestedWindowEnd.Sub(start)
+	if window <= 0 {
+		return start, true
+	}
 
-	return renewIn
+	h := fnv.New64a()
+	h.Write(cert.SerialNumber.Bytes())
+	return start.Add(time.Duration(h.Sum64() % uint64(window))), true
 }
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"crypto/x509"
+	"math/big"
 	"testing"
 	"time"
 
@@ -114,3 +115,68 @@ func TestCalculateDurationUntilRenew(t *testing.T) {
 		}
 	}
 }
+
+func TestCalculateRenewalTimeWithRenewalInfo(t *testing.T) {
+	c := IssuerOptions{
+		RenewBeforeExpiryDuration: v1alpha1.DefaultRenewBefore,
+	}
+	notBefore := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(time.Hour * 24 * 90)
+	defaultRenewalTime := notAfter.Add(-v1alpha1.DefaultRenewBefore)
+	x509Cert := &x509.Certificate{NotBefore: notBefore, NotAfter: notAfter, SerialNumber: big.NewInt(0xbeef)}
+
+	renewalInfo := func(serial string, start, end time.Time) *v1alpha1.CertificateRenewalInfo {
+		return &v1alpha1.CertificateRenewalInfo{
+			SerialNumber:         serial,
+			SuggestedWindowStart: metav1.NewTime(start),
+			SuggestedWindowEnd:   metav1.NewTime(end),
+		}
+	}
+	earlyStart := notBefore.Add(time.Hour * 24)
+	earlyEnd := earlyStart.Add(time.Hour * 24)
+
+	tests := map[string]struct {
+		info          *v1alpha1.CertificateRenewalInfo
+		expectedStart time.Time
+		expectedEnd   time.Time
+	}{
+		"use the default renewal time without renewal information": {
+			expectedStart: defaultRenewalTime,
+			expectedEnd:   defaultRenewalTime,
+		},
+		"renew within an earlier suggested window": {
+			info:          renewalInfo("beef", earlyStart, earlyEnd),
+			expectedStart: earlyStart,
+			expectedEnd:   earlyEnd,
+		},
+		"renew at the start of an empty suggested window": {
+			info:          renewalInfo("beef", earlyStart, earlyStart),
+			expectedStart: earlyStart,
+			expectedEnd:   earlyStart,
+		},
+		"use the default renewal time if the suggested window is later": {
+			info:          renewalInfo("beef", notAfter.Add(-time.Hour*24), notAfter),
+			expectedStart: defaultRenewalTime,
+			expectedEnd:   defaultRenewalTime,
+		},
+		"ignore renewal information recorded for a different certificate": {
+			info:          renewalInfo("cafe", earlyStart, earlyEnd),
+			expectedStart: defaultRenewalTime,
+			expectedEnd:   defaultRenewalTime,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &v1alpha1.Certificate{
+				Status: v1alpha1.CertificateStatus{RenewalInfo: test.info},
+			}
+			renewalTime := c.CalculateRenewalTime(context.Background(), x509Cert, crt)
+			if renewalTime.Before(test.expectedStart) || renewalTime.After(test.expectedEnd) {
+				t.Errorf("expected renewal time between %v and %v, got %v", test.expectedStart, test.expectedEnd, renewalTime)
+			}
+			if again := c.CalculateRenewalTime(context.Background(), x509Cert, crt); !again.Equal(renewalTime) {
+				t.Errorf("expected a stable renewal time %v, got %v", renewalTime, again)
+			}
+		})
+	}
+}
@@ -22,11 +22,13 @@ import (
 	"reflect"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
 
 	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/validation"
+	controllerpkg "github.com/leki75/cert-manager/pkg/controller"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 	"github.com/leki75/cert-manager/pkg/metrics"
 )
@@ -88,8 +90,22 @@ func (c *controller) updateIssuerStatus(old, new *v1alpha1.Issuer) (*v1alpha1.Is
 	if reflect.DeepEqual(old.Status, new.Status) {
 		return nil, nil
 	}
-	// TODO: replace Update call with UpdateStatus. This requires a custom API
-	// server with the /status subresource enabled and/or subresource support
-	// for CRDs (https://github.com/kubernetes/kubernetes/issues/38113)
-	return c.cmClient.CertmanagerV1alpha1().Issuers(new.Namespace).Update(new)
+	var updated *v1alpha1.Issuer
+	err := controllerpkg.RetryStatusUpdate(func() (err error) {
+		updated, err = c.cmClient.CertmanagerV1alpha1().Issuers(new.Namespace).UpdateStatus(new)
+		return err
+	}, func() (bool, error) {
+		latest, err := c.cmClient.CertmanagerV1alpha1().Issuers(new.Namespace).Get(new.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(latest.Status, old.Status) {
+			return false, nil
+		}
+		status := new.Status
+		new = latest.DeepCopy()
+		new.Status = status
+		return true, nil
+	})
+	return updated, err
 }
@@ -20,8 +20,11 @@ import (
 	"reflect"
 	"time"
 
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 )
 
@@ -33,6 +36,36 @@ func DefaultItemBasedRateLimiter() workqueue.RateLimiter {
 	return workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5)
 }
 
+// RetryStatusUpdate calls update to write the status of a resource using the
+// /status subresource. If the write fails due to a conflict, rebase is called
+// to apply the pending status onto the latest version of the resource before
+// the write is retried.
+// rebase should return false if the status of the resource has since been
+// modified elsewhere, as the pending status was computed from a stale copy of
+// the resource. In this case the conflict error is returned so that the
+// resource is re-synced.
+func RetryStatusUpdate(update func() error, rebase func() (bool, error)) error {
+	var updateErr error
+	err := wait.ExponentialBackoff(retry.DefaultBackoff, func() (bool, error) {
+		updateErr = update()
+		if !k8sErrors.IsConflict(updateErr) {
+			return true, updateErr
+		}
+		ok, err := rebase()
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return true, updateErr
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return updateErr
+	}
+	return err
+}
+
 // QueuingEventHandler is an implementation of cache.ResourceEventHandler that
 // simply queues objects that are added/updated/deleted.
 type QueuingEventHandler struct {
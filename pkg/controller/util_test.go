@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRetryStatusUpdate(t *testing.T) {
+	conflictErr := k8sErrors.NewConflict(schema.GroupResource{Resource: "certificates"}, "test", fmt.Errorf("conflict"))
+	otherErr := fmt.Errorf("other error")
+
+	tests := []struct {
+		desc          string
+		updateErrs    []error
+		rebaseOK      bool
+		rebaseErr     error
+		expectUpdates int
+		expectErr     error
+	}{
+		{
+			desc:          "should update status once if there is no conflict",
+			updateErrs:    []error{nil},
+			expectUpdates: 1,
+		},
+		{
+			desc:          "should retry the update after rebasing if there is a conflict",
+			updateErrs:    []error{conflictErr, nil},
+			rebaseOK:      true,
+			expectUpdates: 2,
+		},
+		{
+			desc:          "should not retry the update if the status has been modified",
+			updateErrs:    []error{conflictErr, nil},
+			rebaseOK:      false,
+			expectUpdates: 1,
+			expectErr:     conflictErr,
+		},
+		{
+			desc:          "should return an error if the resource cannot be rebased",
+			updateErrs:    []error{conflictErr, nil},
+			rebaseErr:     otherErr,
+			expectUpdates: 1,
+			expectErr:     otherErr,
+		},
+		{
+			desc:          "should not retry the update on a non-conflict error",
+			updateErrs:    []error{otherErr, nil},
+			rebaseOK:      true,
+			expectUpdates: 1,
+			expectErr:     otherErr,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			updates := 0
+			err := RetryStatusUpdate(func() error {
+				err := test.updateErrs[updates]
+				updates++
+				return err
+			}, func() (bool, error) {
+				return test.rebaseOK, test.rebaseErr
+			})
+			if err != test.expectErr {
+				t.Errorf("expected error %v, got %v", test.expectErr, err)
+			}
+			if updates != test.expectUpdates {
+				t.Errorf("expected %d updates, got %d", test.expectUpdates, updates)
+			}
+		})
+	}
+}
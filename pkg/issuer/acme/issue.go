@@ -33,6 +33,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/leki75/cert-manager/pkg/acme"
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
 	logf "github.com/leki75/cert-manager/pkg/logs"
@@ -60,6 +61,9 @@ func (a *Acme) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Is
 	if generated {
 		// If we have generated a new private key, we return here to ensure we
 		// successfully persist the key before creating any CSRs with it.
+		// If a certificate is already stored for the Certificate, the key is
+		// stored as its next private key so that the existing certificate
+		// continues to be served until the new one has been issued.
 		log.V(logf.DebugLevel).Info("storing newly generated certificate private key")
 		a.Recorder.Eventf(crt, corev1.EventTypeNormal, "Generated", "Generated new private key")
 
@@ -68,7 +72,6 @@ func (a *Acme) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Is
 			return nil, err
 		}
 
-		// Replace the existing secret with one containing only the new private key.
 		return &issuer.IssueResponse{
 			PrivateKey: keyPem,
 		}, nil
@@ -159,7 +162,7 @@ func (a *Acme) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Is
 		}
 
 		if time.Now().Sub(crt.Status.LastFailureTime.Time) < createOrderWaitDuration {
-			return nil, fmt.Errorf("applying acme order back-off for certificate %s/%s because it has failed within the last %s", crt.Namespace, crt.Name, createOrderWaitDuration)
+			return nil, errors.NewPending("applying acme order back-off for certificate %s/%s because it has failed within the last %s", crt.Namespace, crt.Name, createOrderWaitDuration)
 		}
 
 		return nil, a.retryOrder(crt, existingOrder)
@@ -190,6 +193,16 @@ func (a *Acme) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Is
 
 	a.Recorder.Eventf(crt, corev1.EventTypeNormal, "OrderComplete", "Order %q completed successfully", existingOrder.Name)
 
+	// if a renewal of the certificate has been manually requested since the
+	// existing order was created, we recreate the order so we can obtain a
+	// fresh certificate.
+	if apiutil.CertificateRenewalRequested(crt) && crt.Status.LastRenewalRequestTime != nil &&
+		existingOrder.CreationTimestamp.Time.Before(crt.Status.LastRenewalRequestTime.Time) {
+		a.Recorder.Eventf(crt, corev1.EventTypeNormal, "RenewalRequested", "Renewal of certificate requested after Order %q was created. "+
+			"Creating new order...", existingOrder.Name)
+		return nil, a.retryOrder(crt, existingOrder)
+	}
+
 	// we check if the certificate stored on the existing order resource is
 	// nearing expiry.
 	// If it is, we recreate the order so we can obtain a fresh certificate.
@@ -271,18 +284,18 @@ func (a *Acme) getCertificatePrivateKey(ctx context.Context, crt *v1alpha1.Certi
 
 	log.V(4).Info("attempting to fetch existing certificate private key")
 
-	// If a private key already exists, reuse it.
+	// If a private key already exists and does not need rotating, reuse it.
 	// TODO: if we have not observed the update to the Secret resource with the
 	// private key yet, we may in some cases loop and re-generate the private key
 	// over and over. We could attempt to use the live clientset to read the
 	// private key too to avoid this case.
-	key, err := kube.SecretTLSKey(ctx, a.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	key, err := kube.SecretTLSKeyForCertificate(ctx, a.secretsLister, crt)
 	if err == nil {
 		return key, false, nil
 	}
 
-	// We only generate a new private key if the existing one is not found or
-	// contains invalid data.
+	// We only generate a new private key if the existing one is not found,
+	// contains invalid data or must be rotated.
 	// TODO: should we re-generate on InvalidData?
 	if !apierrors.IsNotFound(err) && !errors.IsInvalidData(err) {
 		return nil, false, err
@@ -37,6 +37,7 @@ import (
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/controller"
 	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
@@ -152,6 +153,9 @@ func TestIssueHappyPath(t *testing.T) {
 	testCertExpiredCertOrder := testCertValidOrder.DeepCopy()
 	testCertExpiredCertOrder.Status.Certificate = testCertExpiringSignedBytesPEM
 
+	testCertRenewalRequested := testCert.DeepCopy()
+	apiutil.RequestCertificateRenewal(testCertRenewalRequested, "ManuallyTriggered", "Certificate renewal manually requested")
+
 	tests := map[string]acmeFixture{
 		"generate a new private key if one does not exist": {
 			Certificate: testCert,
@@ -252,6 +256,33 @@ func TestIssueHappyPath(t *testing.T) {
 			},
 			Err: false,
 		},
+		"trigger a renewal if a renewal was manually requested after the order was created": {
+			Certificate: testCertRenewalRequested,
+			Builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testCertValidOrder},
+				KubeObjects:        []runtime.Object{testCertPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(
+						coretesting.NewDeleteAction(v1alpha1.SchemeGroupVersion.WithResource("orders"), testCertValidOrder.Namespace, testCertValidOrder.Name),
+					),
+				},
+			},
+			PreFn: func(t *testing.T, s *acmeFixture) {
+			},
+			CheckFn: func(t *testing.T, s *acmeFixture, args ...interface{}) {
+				returnedCert := args[0].(*v1alpha1.Certificate)
+				resp := args[1].(*issuer.IssueResponse)
+				// err := args[2].(error)
+
+				if resp != nil {
+					t.Errorf("expected IssuerResponse to be nil, but was: %v", resp)
+				}
+				if !reflect.DeepEqual(returnedCert, testCertRenewalRequested) {
+					t.Errorf("output was not as expected: %s", pretty.Diff(returnedCert, testCertRenewalRequested))
+				}
+			},
+			Err: false,
+		},
 		"trigger a renewal if the certificate associated with the order is nearing expiry": {
 			Certificate: testCert,
 			Builder: &testpkg.Builder{
@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	acmeapi "github.com/leki75/cert-manager/third_party/crypto/acme"
+)
+
+// defaultRenewalInfoCheckInterval is how long to wait before requesting
+// renewal information again when the ACME server does not specify a
+// Retry-After duration.
+const defaultRenewalInfoCheckInterval = time.Hour * 6
+
+var _ issuer.RenewalInfoProvider = &Acme{}
+
+// RenewalInfo requests the suggested renewal window for the given certificate
+// from the ACME server's renewalInfo endpoint.
+// ErrRenewalInfoNotSupported is returned if the ACME server does not
+// advertise the endpoint, or the certificate has no authority key identifier.
+func (a *Acme) RenewalInfo(ctx context.Context, crt *v1alpha1.Certificate, cert *x509.Certificate) (*v1alpha1.CertificateRenewalInfo, error) {
+	if len(cert.AuthorityKeyId) == 0 {
+		return nil, issuer.ErrRenewalInfoNotSupported
+	}
+
+	cl, err := a.helper.ClientForIssuer(a.issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := cl.GetRenewalInfo(ctx, cert)
+	if err == acmeapi.ErrRenewalInfoNotSupported {
+		return nil, issuer.ErrRenewalInfoNotSupported
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error requesting renewal information from ACME server: %v", err)
+	}
+
+	nextCheck := info.RetryAfter
+	if now := a.clock.Now(); !nextCheck.After(now) {
+		nextCheck = now.Add(defaultRenewalInfoCheckInterval)
+	}
+
+	return &v1alpha1.CertificateRenewalInfo{
+		SerialNumber:         cert.SerialNumber.Text(16),
+		SuggestedWindowStart: metav1.NewTime(info.SuggestedWindow.Start),
+		SuggestedWindowEnd:   metav1.NewTime(info.SuggestedWindow.End),
+		ExplanationURL:       info.ExplanationURL,
+		NextCheckTime:        metav1.NewTime(nextCheck),
+	}, nil
+}
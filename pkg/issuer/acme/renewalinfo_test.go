@@ -0,0 +1,119 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/leki75/cert-manager/pkg/acme/client"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	"github.com/leki75/cert-manager/test/unit/gen"
+	acmeapi "github.com/leki75/cert-manager/third_party/crypto/acme"
+)
+
+func TestRenewalInfo(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{SerialNumber: big.NewInt(0xbeef), AuthorityKeyId: []byte{1, 2, 3}}
+	window := acmeapi.RenewalWindow{Start: now.Add(time.Hour), End: now.Add(time.Hour * 2)}
+
+	tests := map[string]struct {
+		cert         *x509.Certificate
+		info         *acmeapi.RenewalInfo
+		err          error
+		expectedNext time.Time
+		expectedErr  error
+		called       bool
+	}{
+		"return the suggested window and retry after time": {
+			cert:         cert,
+			info:         &acmeapi.RenewalInfo{SuggestedWindow: window, ExplanationURL: "https://example.com/incident", RetryAfter: now.Add(time.Hour * 3)},
+			expectedNext: now.Add(time.Hour * 3),
+			called:       true,
+		},
+		"check again after the default interval if no retry after time is given": {
+			cert:         cert,
+			info:         &acmeapi.RenewalInfo{SuggestedWindow: window},
+			expectedNext: now.Add(defaultRenewalInfoCheckInterval),
+			called:       true,
+		},
+		"return not supported if the server does not advertise renewal information": {
+			cert:        cert,
+			err:         acmeapi.ErrRenewalInfoNotSupported,
+			expectedErr: issuer.ErrRenewalInfoNotSupported,
+			called:      true,
+		},
+		"return not supported if the certificate has no authority key identifier": {
+			cert:        &x509.Certificate{SerialNumber: big.NewInt(0xbeef)},
+			expectedErr: issuer.ErrRenewalInfoNotSupported,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			s := &acmeFixture{
+				Issuer: gen.Issuer("acme-issuer", gen.SetIssuerACME(v1alpha1.ACMEIssuer{})),
+				Clock:  fakeclock.NewFakeClock(now),
+				Client: &client.FakeACME{
+					FakeGetRenewalInfo: func(_ context.Context, c *x509.Certificate) (*acmeapi.RenewalInfo, error) {
+						called = true
+						if c != test.cert {
+							t.Errorf("unexpected certificate passed to GetRenewalInfo")
+						}
+						return test.info, test.err
+					},
+				},
+			}
+			s.Setup(t)
+			defer s.Finish(t)
+
+			info, err := s.Acme.RenewalInfo(s.Ctx, gen.Certificate("test"), test.cert)
+			if called != test.called {
+				t.Errorf("expected GetRenewalInfo to be called %t, got %t", test.called, called)
+			}
+			if test.expectedErr != nil {
+				if !errors.Is(err, test.expectedErr) {
+					t.Errorf("expected error %v, got: %v", test.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if info.SerialNumber != "beef" {
+				t.Errorf("expected serial number %q, got %q", "beef", info.SerialNumber)
+			}
+			if !info.SuggestedWindowStart.Time.Equal(window.Start) || !info.SuggestedWindowEnd.Time.Equal(window.End) {
+				t.Errorf("unexpected suggested window %v - %v", info.SuggestedWindowStart, info.SuggestedWindowEnd)
+			}
+			if info.ExplanationURL != test.info.ExplanationURL {
+				t.Errorf("expected explanation URL %q, got %q", test.info.ExplanationURL, info.ExplanationURL)
+			}
+			if !info.NextCheckTime.Time.Equal(test.expectedNext) {
+				t.Errorf("expected next check time %v, got %v", test.expectedNext, info.NextCheckTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	acmeapi "github.com/leki75/cert-manager/third_party/crypto/acme"
+)
+
+// revocationReasons maps cert-manager revocation reasons to the CRL reason
+// codes defined in RFC 5280 section 5.3.1.
+var revocationReasons = map[v1alpha1.RevocationReason]acmeapi.CRLReasonCode{
+	v1alpha1.RevocationReasonUnspecified:          acmeapi.CRLReasonUnspecified,
+	v1alpha1.RevocationReasonKeyCompromise:        acmeapi.CRLReasonKeyCompromise,
+	v1alpha1.RevocationReasonCACompromise:         acmeapi.CRLReasonCACompromise,
+	v1alpha1.RevocationReasonAffiliationChanged:   acmeapi.CRLReasonAffiliationChanged,
+	v1alpha1.RevocationReasonSuperseded:           acmeapi.CRLReasonSuperseded,
+	v1alpha1.RevocationReasonCessationOfOperation: acmeapi.CRLReasonCessationOfOperation,
+}
+
+// Revoke will revoke the given certificate with the ACME server, signing the
+// request with the issuer's account key.
+func (a *Acme) Revoke(ctx context.Context, crt *v1alpha1.Certificate, cert *x509.Certificate, reason v1alpha1.RevocationReason) error {
+	log := logf.FromContext(ctx, "revoke")
+
+	code, ok := revocationReasons[reason]
+	if !ok {
+		return fmt.Errorf("unsupported revocation reason %q", reason)
+	}
+
+	cl, err := a.helper.ClientForIssuer(a.issuer)
+	if err != nil {
+		return err
+	}
+
+	if err := cl.RevokeCert(ctx, nil, cert.Raw, code); err != nil {
+		return fmt.Errorf("error revoking certificate with ACME server: %v", err)
+	}
+
+	log.Info("certificate revoked")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"testing"
+
+	"github.com/leki75/cert-manager/pkg/acme/client"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/test/unit/gen"
+	acmeapi "github.com/leki75/cert-manager/third_party/crypto/acme"
+)
+
+func TestRevoke(t *testing.T) {
+	cert := &x509.Certificate{Raw: []byte("certificate")}
+
+	tests := map[string]struct {
+		reason       v1alpha1.RevocationReason
+		expectedCode acmeapi.CRLReasonCode
+		called       bool
+		err          bool
+	}{
+		"revoke a certificate with the key compromise reason code": {
+			reason:       v1alpha1.RevocationReasonKeyCompromise,
+			expectedCode: acmeapi.CRLReasonKeyCompromise,
+			called:       true,
+		},
+		"revoke a certificate with the cessation of operation reason code": {
+			reason:       v1alpha1.RevocationReasonCessationOfOperation,
+			expectedCode: acmeapi.CRLReasonCessationOfOperation,
+			called:       true,
+		},
+		"reject an unknown revocation reason": {
+			reason: v1alpha1.RevocationReason("Unknown"),
+			err:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			s := &acmeFixture{
+				Issuer: gen.Issuer("acme-issuer", gen.SetIssuerACME(v1alpha1.ACMEIssuer{})),
+				Client: &client.FakeACME{
+					FakeRevokeCert: func(_ context.Context, key crypto.Signer, der []byte, code acmeapi.CRLReasonCode) error {
+						called = true
+						if key != nil {
+							t.Errorf("expected revocation to be signed with the account key")
+						}
+						if !bytes.Equal(der, cert.Raw) {
+							t.Errorf("unexpected certificate passed to RevokeCert")
+						}
+						if code != test.expectedCode {
+							t.Errorf("expected reason code %d, got %d", test.expectedCode, code)
+						}
+						return nil
+					},
+				},
+			}
+			s.Setup(t)
+			defer s.Finish(t)
+
+			err := s.Acme.Revoke(s.Ctx, gen.Certificate("test"), cert, test.reason)
+			if test.err != (err != nil) {
+				t.Errorf("expected error %t, got: %v", test.err, err)
+			}
+			if called != test.called {
+				t.Errorf("expected RevokeCert to be called %t, got %t", test.called, called)
+			}
+		})
+	}
+}
@@ -18,7 +18,8 @@ package acme
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
+	"encoding/base64"
 	"fmt"
 	"net/url"
 	"strings"
@@ -41,6 +42,7 @@ const (
 	errorAccountRegistrationFailed = "ErrRegisterACMEAccount"
 	errorAccountVerificationFailed = "ErrVerifyACMEAccount"
 	errorAccountUpdateFailed       = "ErrUpdateACMEAccount"
+	errorExternalAccountBinding    = "ErrExternalAccountBinding"
 
 	successAccountRegistered = "ACMEAccountRegistered"
 	successAccountVerified   = "ACMEAccountVerified"
@@ -48,6 +50,7 @@ const (
 	messageAccountRegistrationFailed = "Failed to register ACME account: "
 	messageAccountVerificationFailed = "Failed to verify ACME account: "
 	messageAccountUpdateFailed       = "Failed to update ACME account:"
+	messageExternalAccountBinding    = "Failed to bind ACME account to external account: "
 	messageAccountRegistered         = "The ACME account was registered with the ACME server"
 	messageAccountVerified           = "The ACME account was verified with the ACME server"
 )
@@ -168,14 +171,34 @@ func (a *Acme) Setup(ctx context.Context) error {
 		a.issuer.GetStatus().ACMEStatus().URI = ""
 	}
 
+	eab, err := a.externalAccountBinding(ns)
+	if err != nil {
+		s := messageExternalAccountBinding + err.Error()
+		log.Error(err, "failed to read external account binding")
+		a.Recorder.Event(a.issuer, v1.EventTypeWarning, errorExternalAccountBinding, s)
+		apiutil.SetIssuerCondition(a.issuer, v1alpha1.IssuerConditionReady, v1alpha1.ConditionFalse, errorExternalAccountBinding, s)
+		// a missing or invalid Secret will trigger a resync once it is
+		// updated, so there is no need to retry these errors.
+		if apierrors.IsNotFound(err) || errors.IsInvalidData(err) {
+			return nil
+		}
+		return err
+	}
+
 	// registerAccount will also verify the account exists if it already
 	// exists.
-	account, err := a.registerAccount(ctx, cl)
+	account, err := a.registerAccount(ctx, cl, eab)
 	if err != nil {
 		s := messageAccountVerificationFailed + err.Error()
 		log.Error(err, "failed to verify ACME account")
-		a.Recorder.Event(a.issuer, v1.EventTypeWarning, errorAccountVerificationFailed, s)
-		apiutil.SetIssuerCondition(a.issuer, v1alpha1.IssuerConditionReady, v1alpha1.ConditionFalse, errorAccountRegistrationFailed, s)
+		if isExternalAccountBindingError(err, eab) {
+			s = messageExternalAccountBinding + err.Error()
+			a.Recorder.Event(a.issuer, v1.EventTypeWarning, errorExternalAccountBinding, s)
+			apiutil.SetIssuerCondition(a.issuer, v1alpha1.IssuerConditionReady, v1alpha1.ConditionFalse, errorExternalAccountBinding, s)
+		} else {
+			a.Recorder.Event(a.issuer, v1.EventTypeWarning, errorAccountVerificationFailed, s)
+			apiutil.SetIssuerCondition(a.issuer, v1alpha1.IssuerConditionReady, v1alpha1.ConditionFalse, errorAccountRegistrationFailed, s)
+		}
 
 		acmeErr, ok := err.(*acmeapi.Error)
 		// If this is not an ACME error, we will simply return it and retry later
@@ -267,8 +290,9 @@ func ensureEmailUpToDate(ctx context.Context, cl client.Interface, acc *acmeapi.
 // registerAccount will register a new ACME account with the server. If an
 // account with the clients private key already exists, it will attempt to look
 // up and verify the corresponding account, and will return that. If this fails
-// due to a not found error it will register a new account with the given key.
-func (a *Acme) registerAccount(ctx context.Context, cl client.Interface) (*acmeapi.Account, error) {
+// due to a not found error it will register a new account with the given key,
+// bound to eab if it is not nil.
+func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, eab *acmeapi.ExternalAccountBinding) (*acmeapi.Account, error) {
 	// check if the account already exists
 	acc, err := cl.GetAccount(ctx)
 	if err == nil {
@@ -288,8 +312,9 @@ func (a *Acme) registerAccount(ctx context.Context, cl client.Interface) (*acmea
 	}
 
 	acc = &acmeapi.Account{
-		Contact:     emailurl,
-		TermsAgreed: true,
+		Contact:                emailurl,
+		TermsAgreed:            true,
+		ExternalAccountBinding: eab,
 	}
 
 	acc, err = cl.CreateAccount(ctx, acc)
@@ -304,11 +329,67 @@ func (a *Acme) registerAccount(ctx context.Context, cl client.Interface) (*acmea
 	return acc, nil
 }
 
-// createAccountPrivateKey will generate a new RSA private key, and create it
-// as a secret resource in the apiserver.
-func (a *Acme) createAccountPrivateKey(sel v1alpha1.SecretKeySelector, ns string) (*rsa.PrivateKey, error) {
+// externalAccountBinding reads the external account binding configured on
+// the issuer from the Secret it references in namespace ns. It returns nil
+// if no external account binding is configured.
+func (a *Acme) externalAccountBinding(ns string) (*acmeapi.ExternalAccountBinding, error) {
+	eab := a.issuer.GetSpec().ACME.ExternalAccountBinding
+	if eab == nil {
+		return nil, nil
+	}
+
+	secret, err := a.secretsLister.Secrets(ns).Get(eab.Key.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes, ok := secret.Data[eab.Key.Key]
+	if !ok {
+		return nil, errors.NewInvalidData("no data for %q in secret '%s/%s'", eab.Key.Key, ns, eab.Key.Name)
+	}
+
+	// CAs generally hand out the MAC key base64url encoded, so accept it
+	// with or without padding.
+	encoded := strings.TrimRight(strings.TrimSpace(string(keyBytes)), "=")
+	key, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.NewInvalidData("failed to decode external account binding key in secret '%s/%s': %v", ns, eab.Key.Name, err)
+	}
+
+	return &acmeapi.ExternalAccountBinding{
+		KID:       eab.KeyID,
+		Key:       key,
+		Algorithm: string(eab.KeyAlgorithm),
+	}, nil
+}
+
+// isExternalAccountBindingError returns true if err indicates that the ACME
+// server requires an external account binding, or rejected the one provided.
+func isExternalAccountBindingError(err error, eab *acmeapi.ExternalAccountBinding) bool {
+	acmeErr, ok := err.(*acmeapi.Error)
+	if !ok {
+		return false
+	}
+	switch acmeErr.Type {
+	case "urn:ietf:params:acme:error:externalAccountRequired":
+		return true
+	case "urn:ietf:params:acme:error:unauthorized":
+		return eab != nil
+	}
+	return false
+}
+
+// createAccountPrivateKey will generate a new private key of the algorithm
+// and size configured on the issuer, and create it as a secret resource in
+// the apiserver.
+func (a *Acme) createAccountPrivateKey(sel v1alpha1.SecretKeySelector, ns string) (crypto.Signer, error) {
 	sel = acme.PrivateKeySelector(sel)
-	accountPrivKey, err := pki.GenerateRSAPrivateKey(pki.MinRSAKeySize)
+	accountPrivKey, err := generateAccountPrivateKey(a.issuer.GetSpec().ACME)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes, err := pki.EncodePrivateKey(accountPrivKey, v1alpha1.PKCS1)
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +400,7 @@ func (a *Acme) createAccountPrivateKey(sel v1alpha1.SecretKeySelector, ns string
 			Namespace: ns,
 		},
 		Data: map[string][]byte{
-			sel.Key: pki.EncodePKCS1PrivateKey(accountPrivKey),
+			sel.Key: keyBytes,
 		},
 	})
 
@@ -330,6 +411,30 @@ func (a *Acme) createAccountPrivateKey(sel v1alpha1.SecretKeySelector, ns string
 	return accountPrivKey, err
 }
 
+// generateAccountPrivateKey generates a new ACME account private key using the
+// algorithm and size configured on the given ACMEIssuer.
+func generateAccountPrivateKey(spec *v1alpha1.ACMEIssuer) (crypto.Signer, error) {
+	switch spec.PrivateKeyAlgorithm {
+	case v1alpha1.KeyAlgorithm(""), v1alpha1.RSAKeyAlgorithm:
+		keySize := pki.MinRSAKeySize
+		if spec.PrivateKeySize > 0 {
+			keySize = spec.PrivateKeySize
+		}
+		return pki.GenerateRSAPrivateKey(keySize)
+	case v1alpha1.ECDSAKeyAlgorithm:
+		keySize := pki.ECCurve256
+		if spec.PrivateKeySize > 0 {
+			keySize = spec.PrivateKeySize
+		}
+		if keySize != pki.ECCurve256 && keySize != pki.ECCurve384 {
+			return nil, fmt.Errorf("unsupported ecdsa key size for ACME account: %d", keySize)
+		}
+		return pki.GenerateECPrivateKey(keySize)
+	default:
+		return nil, fmt.Errorf("unsupported private key algorithm for ACME account: %q", spec.PrivateKeyAlgorithm)
+	}
+}
+
 var acmev1ToV2Mappings = map[string]string{
 	"https://acme-v01.api.letsencrypt.org/directory":      "https://acme-v02.api.letsencrypt.org/directory",
 	"https://acme-staging.api.letsencrypt.org/directory":  "https://acme-staging-v02.api.letsencrypt.org/directory",
@@ -0,0 +1,237 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/leki75/cert-manager/pkg/acme/client"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/test/unit/gen"
+	acmeapi "github.com/leki75/cert-manager/third_party/crypto/acme"
+)
+
+func TestExternalAccountBinding(t *testing.T) {
+	eabSecret := func(data string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "eab", Namespace: gen.DefaultTestNamespace},
+			Data:       map[string][]byte{"hmac": []byte(data)},
+		}
+	}
+	eabIssuer := gen.Issuer("acme-issuer",
+		gen.SetIssuerACME(v1alpha1.ACMEIssuer{
+			ExternalAccountBinding: &v1alpha1.ACMEExternalAccountBinding{
+				KeyID: "kid-1",
+				Key: v1alpha1.SecretKeySelector{
+					LocalObjectReference: v1alpha1.LocalObjectReference{Name: "eab"},
+					Key:                  "hmac",
+				},
+				KeyAlgorithm: v1alpha1.HS384,
+			},
+		}),
+	)
+
+	tests := map[string]struct {
+		issuer      v1alpha1.GenericIssuer
+		kubeObjects []runtime.Object
+		expected    *acmeapi.ExternalAccountBinding
+		errCheck    func(error) bool
+	}{
+		"return nil if no external account binding is configured": {
+			issuer: gen.Issuer("acme-issuer", gen.SetIssuerACME(v1alpha1.ACMEIssuer{})),
+		},
+		"return a not found error if the secret does not exist": {
+			issuer:   eabIssuer,
+			errCheck: apierrors.IsNotFound,
+		},
+		"return an invalid data error if the secret key is not base64url encoded": {
+			issuer:      eabIssuer,
+			kubeObjects: []runtime.Object{eabSecret("not+base64url")},
+			errCheck:    errors.IsInvalidData,
+		},
+		"decode a padded base64url encoded key": {
+			issuer:      eabIssuer,
+			kubeObjects: []runtime.Object{eabSecret("c2VjcmV0LWtleQ==\n")},
+			expected: &acmeapi.ExternalAccountBinding{
+				KID:       "kid-1",
+				Key:       []byte("secret-key"),
+				Algorithm: "HS384",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &acmeFixture{
+				Issuer:  test.issuer,
+				Builder: &testpkg.Builder{KubeObjects: test.kubeObjects},
+			}
+			s.Setup(t)
+			defer s.Finish(t)
+
+			eab, err := s.Acme.externalAccountBinding(gen.DefaultTestNamespace)
+			if test.errCheck != nil {
+				if !test.errCheck(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.expected == nil {
+				if eab != nil {
+					t.Errorf("expected no external account binding, got: %+v", eab)
+				}
+				return
+			}
+			if eab == nil || eab.KID != test.expected.KID || eab.Algorithm != test.expected.Algorithm ||
+				!bytes.Equal(eab.Key, test.expected.Key) {
+				t.Errorf("expected external account binding %+v, got: %+v", test.expected, eab)
+			}
+		})
+	}
+}
+
+func TestRegisterAccountExternalAccountBinding(t *testing.T) {
+	eab := &acmeapi.ExternalAccountBinding{KID: "kid-1", Key: []byte("secret-key")}
+	s := &acmeFixture{
+		Issuer: gen.Issuer("acme-issuer", gen.SetIssuerACME(v1alpha1.ACMEIssuer{})),
+		Client: &client.FakeACME{
+			FakeGetAccount: func(context.Context) (*acmeapi.Account, error) {
+				return nil, &acmeapi.Error{StatusCode: http.StatusBadRequest, Type: "urn:ietf:params:acme:error:accountDoesNotExist"}
+			},
+			FakeCreateAccount: func(_ context.Context, a *acmeapi.Account) (*acmeapi.Account, error) {
+				if a.ExternalAccountBinding != eab {
+					t.Errorf("expected external account binding to be passed to CreateAccount, got: %+v", a.ExternalAccountBinding)
+				}
+				return &acmeapi.Account{URL: "https://example.com/acme/account/1"}, nil
+			},
+		},
+	}
+	s.Setup(t)
+	defer s.Finish(t)
+
+	acc, err := s.Acme.registerAccount(s.Ctx, s.Client, eab)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if acc.URL != "https://example.com/acme/account/1" {
+		t.Errorf("unexpected account URL %q", acc.URL)
+	}
+}
+
+func TestIsExternalAccountBindingError(t *testing.T) {
+	eab := &acmeapi.ExternalAccountBinding{KID: "kid-1", Key: []byte("secret-key")}
+	tests := map[string]struct {
+		err      error
+		eab      *acmeapi.ExternalAccountBinding
+		expected bool
+	}{
+		"external account required": {
+			err:      &acmeapi.Error{Type: "urn:ietf:params:acme:error:externalAccountRequired"},
+			expected: true,
+		},
+		"unauthorized with an external account binding": {
+			err:      &acmeapi.Error{Type: "urn:ietf:params:acme:error:unauthorized"},
+			eab:      eab,
+			expected: true,
+		},
+		"unauthorized without an external account binding": {
+			err: &acmeapi.Error{Type: "urn:ietf:params:acme:error:unauthorized"},
+		},
+		"non-ACME error": {
+			err: apierrors.NewNotFound(corev1.Resource("secrets"), "eab"),
+			eab: eab,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := isExternalAccountBindingError(test.err, test.eab); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGenerateAccountPrivateKey(t *testing.T) {
+	tests := map[string]struct {
+		spec  v1alpha1.ACMEIssuer
+		check func(crypto.Signer) bool
+		err   bool
+	}{
+		"default to a 2048 bit RSA key": {
+			check: func(pk crypto.Signer) bool {
+				k, ok := pk.(*rsa.PrivateKey)
+				return ok && k.N.BitLen() == 2048
+			},
+		},
+		"generate a P-256 ECDSA key by default": {
+			spec: v1alpha1.ACMEIssuer{PrivateKeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm},
+			check: func(pk crypto.Signer) bool {
+				k, ok := pk.(*ecdsa.PrivateKey)
+				return ok && k.Curve == elliptic.P256()
+			},
+		},
+		"generate a P-384 ECDSA key": {
+			spec: v1alpha1.ACMEIssuer{PrivateKeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm, PrivateKeySize: 384},
+			check: func(pk crypto.Signer) bool {
+				k, ok := pk.(*ecdsa.PrivateKey)
+				return ok && k.Curve == elliptic.P384()
+			},
+		},
+		"reject a P-521 ECDSA key": {
+			spec: v1alpha1.ACMEIssuer{PrivateKeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm, PrivateKeySize: 521},
+			err:  true,
+		},
+		"reject an Ed25519 key": {
+			spec: v1alpha1.ACMEIssuer{PrivateKeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm},
+			err:  true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pk, err := generateAccountPrivateKey(&test.spec)
+			if test.err {
+				if err == nil {
+					t.Errorf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.check(pk) {
+				t.Errorf("unexpected private key generated: %T", pk)
+			}
+		})
+	}
+}
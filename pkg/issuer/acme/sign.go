@@ -18,12 +18,130 @@ package acme
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/leki75/cert-manager/pkg/acme"
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+var (
+	certificateRequestGvk = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.CertificateRequestKind)
 )
 
+// Sign will create an Order resource for the given CertificateRequest, and
+// return the signed certificate once the Order has been completed.
+// As the spec of a CertificateRequest cannot be changed once created, a
+// failed Order will cause the CertificateRequest to be marked as failed
+// rather than the Order being retried.
 func (a *Acme) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
-	return nil, errors.New("sign not implemented by ACME issuer")
+	log := logf.FromContext(ctx, "sign")
+
+	expectedOrder, err := buildOrderForCertificateRequest(cr)
+	if err != nil {
+		log.Error(err, "error building order for certificate request")
+		a.Recorder.Eventf(cr, corev1.EventTypeWarning, "BadConfig", "Error building Order resource: %v", err)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse,
+			v1alpha1.CertificateRequestReasonFailed, fmt.Sprintf("Failed to build Order resource: %v", err))
+		return nil, nil
+	}
+	log = logf.WithRelatedResource(log, expectedOrder)
+
+	existingOrder, err := a.orderLister.Orders(expectedOrder.Namespace).Get(expectedOrder.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "error getting existing Order resource")
+		return nil, err
+	}
+	if existingOrder == nil {
+		o, err := a.CMClient.CertmanagerV1alpha1().Orders(expectedOrder.Namespace).Create(expectedOrder)
+		if err != nil {
+			a.Recorder.Eventf(cr, corev1.EventTypeWarning, "CreateError", "Failed to create Order resource: %v", err)
+			return nil, err
+		}
+
+		a.Recorder.Eventf(cr, corev1.EventTypeNormal, "OrderCreated", "Created Order resource %q", o.Name)
+		log.V(logf.DebugLevel).Info("created new Order resource for CertificateRequest")
+
+		// The change to the Order resource will trigger the
+		// CertificateRequest to be re-synced.
+		return nil, nil
+	}
+
+	if !metav1.IsControlledBy(existingOrder, cr) {
+		err := fmt.Errorf("existing Order %q is not owned by this CertificateRequest", existingOrder.Name)
+		log.Error(err, "refusing to use Order resource")
+		a.Recorder.Eventf(cr, corev1.EventTypeWarning, "OrderConflict", err.Error())
+		return nil, err
+	}
+
+	if acme.IsFailureState(existingOrder.Status.State) {
+		a.Recorder.Eventf(cr, corev1.EventTypeWarning, "FailedOrder", "Order %q failed: %s", existingOrder.Name, existingOrder.Status.Reason)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady, v1alpha1.ConditionFalse,
+			v1alpha1.CertificateRequestReasonFailed, fmt.Sprintf("Order %q entered state %q: %s",
+				existingOrder.Name, existingOrder.Status.State, existingOrder.Status.Reason))
+		return nil, nil
+	}
+
+	if existingOrder.Status.State != v1alpha1.Valid {
+		log.Info("Order is not in 'valid' state. Waiting for Order to transition before attempting to issue Certificate.")
+		return nil, nil
+	}
+
+	// this should never happen
+	if existingOrder.Status.Certificate == nil {
+		a.Recorder.Eventf(cr, corev1.EventTypeWarning, "NoCertificate", "Empty certificate data retrieved from ACME server")
+		return nil, fmt.Errorf("order in a valid state but certificate data not set")
+	}
+
+	a.Recorder.Eventf(cr, corev1.EventTypeNormal, "OrderComplete", "Order %q completed successfully", existingOrder.Name)
+
+	return &issuer.IssueResponse{
+		Certificate: existingOrder.Status.Certificate,
+	}, nil
+}
+
+// buildOrderForCertificateRequest builds the Order resource used to obtain a
+// signed certificate for the given CertificateRequest.
+func buildOrderForCertificateRequest(cr *v1alpha1.CertificateRequest) (*v1alpha1.Order, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := v1alpha1.OrderSpec{
+		CSR:        csr.Raw,
+		IssuerRef:  cr.Spec.IssuerRef,
+		CommonName: csr.Subject.CommonName,
+		DNSNames:   csr.DNSNames,
+	}
+	hash, err := hashOrder(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy across labels from the CertificateRequest resource onto the
+	// Order, so that they can be used to select challenge solvers.
+	lbls := make(map[string]string, len(cr.Labels))
+	for k, v := range cr.Labels {
+		lbls[k] = v
+	}
+
+	// truncate certificate request name so final name will be <= 63
+	// characters.
+	return &v1alpha1.Order{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%.52s-%d", cr.Name, hash),
+			Namespace:       cr.Namespace,
+			Labels:          lbls,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cr, certificateRequestGvk)},
+		},
+		Spec: spec,
+	}, nil
 }
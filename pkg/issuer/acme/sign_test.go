@@ -0,0 +1,198 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func TestSign(t *testing.T) {
+	pk := generatePrivateKey(t)
+	csrDER, err := pki.EncodeCSR(&x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: "example.com"},
+		DNSNames:           []string{"example.com", "www.example.com"},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}, pk)
+	if err != nil {
+		t.Fatalf("error generating csr: %v", err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	_, certPEM := generateSelfSignedCert(t, &v1alpha1.Certificate{
+		Spec: v1alpha1.CertificateSpec{CommonName: "example.com"},
+	}, pk, time.Now(), time.Hour)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(v1alpha1.ObjectReference{Name: "acme-issuer"}),
+		gen.SetCertificateRequestLabels(map[string]string{"solver": "http01"}),
+	)
+	baseOrder, err := buildOrderForCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatalf("error building order: %v", err)
+	}
+	orderWithState := func(state v1alpha1.State, cert []byte) *v1alpha1.Order {
+		o := baseOrder.DeepCopy()
+		o.Status.State = state
+		o.Status.Certificate = cert
+		return o
+	}
+	unownedOrder := baseOrder.DeepCopy()
+	unownedOrder.OwnerReferences = nil
+
+	failedCheck := func(t *testing.T, s *acmeFixture, args ...interface{}) {
+		cr := args[0].(*v1alpha1.CertificateRequest)
+		if !apiutil.CertificateRequestHasCondition(cr, v1alpha1.CertificateRequestCondition{
+			Type:   v1alpha1.CertificateRequestConditionReady,
+			Status: v1alpha1.ConditionFalse,
+			Reason: v1alpha1.CertificateRequestReasonFailed,
+		}) {
+			t.Errorf("expected CertificateRequest to be marked as failed, got conditions: %+v", cr.Status.Conditions)
+		}
+		if resp := args[1].(*issuer.IssueResponse); resp != nil {
+			t.Errorf("expected no response, got: %+v", resp)
+		}
+	}
+	noResponseCheck := func(t *testing.T, s *acmeFixture, args ...interface{}) {
+		if resp := args[1].(*issuer.IssueResponse); resp != nil {
+			t.Errorf("expected no response, got: %+v", resp)
+		}
+	}
+
+	tests := map[string]struct {
+		cr *v1alpha1.CertificateRequest
+		acmeFixture
+	}{
+		"create a new Order for the CSR if one does not exist": {
+			cr: baseCR,
+			acmeFixture: acmeFixture{
+				Builder: &testpkg.Builder{
+					ExpectedActions: []testpkg.Action{
+						testpkg.NewAction(coretesting.NewCreateAction(
+							v1alpha1.SchemeGroupVersion.WithResource("orders"),
+							gen.DefaultTestNamespace,
+							baseOrder,
+						)),
+					},
+				},
+				CheckFn: func(t *testing.T, s *acmeFixture, args ...interface{}) {
+					noResponseCheck(t, s, args...)
+					if !bytes.Equal(baseOrder.Spec.CSR, csrDER) {
+						t.Errorf("expected Order to contain the DER encoded CSR")
+					}
+					if baseOrder.Labels["solver"] != "http01" {
+						t.Errorf("expected labels to be copied onto the Order, got: %v", baseOrder.Labels)
+					}
+				},
+			},
+		},
+		"wait for a pending Order to complete": {
+			cr: baseCR,
+			acmeFixture: acmeFixture{
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{orderWithState(v1alpha1.Pending, nil)},
+				},
+				CheckFn: noResponseCheck,
+			},
+		},
+		"return the certificate from a valid Order": {
+			cr: baseCR,
+			acmeFixture: acmeFixture{
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{orderWithState(v1alpha1.Valid, certPEM)},
+				},
+				CheckFn: func(t *testing.T, s *acmeFixture, args ...interface{}) {
+					resp := args[1].(*issuer.IssueResponse)
+					if resp == nil || !bytes.Equal(resp.Certificate, certPEM) {
+						t.Errorf("expected response to contain the Order's certificate, got: %+v", resp)
+					}
+				},
+			},
+		},
+		"return an error if a valid Order does not contain a certificate": {
+			cr: baseCR,
+			acmeFixture: acmeFixture{
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{orderWithState(v1alpha1.Valid, nil)},
+				},
+				CheckFn: noResponseCheck,
+				Err:     true,
+			},
+		},
+		"mark the CertificateRequest as failed if the Order has failed": {
+			cr: baseCR,
+			acmeFixture: acmeFixture{
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{orderWithState(v1alpha1.Invalid, nil)},
+				},
+				CheckFn: failedCheck,
+			},
+		},
+		"return an error if the existing Order is not owned by the CertificateRequest": {
+			cr: baseCR,
+			acmeFixture: acmeFixture{
+				Builder: &testpkg.Builder{
+					CertManagerObjects: []runtime.Object{unownedOrder},
+				},
+				CheckFn: noResponseCheck,
+				Err:     true,
+			},
+		},
+		"mark the CertificateRequest as failed if the CSR cannot be decoded": {
+			cr: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestCSR([]byte("bad-csr")),
+			),
+			acmeFixture: acmeFixture{
+				CheckFn: failedCheck,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.Builder == nil {
+				test.Builder = &testpkg.Builder{}
+			}
+			test.Builder.T = t
+			test.Setup(t)
+			cr := test.cr.DeepCopy()
+			resp, err := test.Acme.Sign(test.Ctx, cr)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, cr, resp, err)
+		})
+	}
+}
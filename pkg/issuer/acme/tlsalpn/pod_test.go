@@ -0,0 +1,264 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/controller"
+)
+
+func TestBuildPod(t *testing.T) {
+	s := &Solver{Context: &controller.Context{
+		ACMEOptions: controller.ACMEOptions{HTTP01SolverImage: "acmesolver:test"},
+	}}
+	ch := &v1alpha1.Challenge{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: v1alpha1.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "token.thumbprint",
+			Solver: &v1alpha1.ACMEChallengeSolver{
+				TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+					PodTemplate: &v1alpha1.ACMEChallengeSolverHTTP01IngressPodTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"team": "edge"},
+						},
+						Spec: v1alpha1.ACMEChallengeSolverHTTP01IngressPodSpec{
+							NodeSelector: map[string]string{"node": "edge"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod := s.buildPod(ch)
+
+	container := pod.Spec.Containers[0]
+	if container.Image != "acmesolver:test" {
+		t.Errorf("expected image %q, got %q", "acmesolver:test", container.Image)
+	}
+	expectedArgs := []string{
+		"--challenge-type=tls-alpn-01",
+		"--listen-port=8443",
+		"--domain=example.com",
+		"--key=token.thumbprint",
+	}
+	if !reflect.DeepEqual(container.Args, expectedArgs) {
+		t.Errorf("expected args %v, got %v", expectedArgs, container.Args)
+	}
+	if !reflect.DeepEqual(container.Ports, []corev1.ContainerPort{{Name: "https", ContainerPort: acmeSolverListenPort}}) {
+		t.Errorf("unexpected container ports: %+v", container.Ports)
+	}
+	if pod.Labels["team"] != "edge" || pod.Labels[solverIdentificationLabelKey] != "true" {
+		t.Errorf("expected pod template labels to be merged with default labels, got: %v", pod.Labels)
+	}
+	if pod.Spec.NodeSelector["node"] != "edge" {
+		t.Errorf("expected pod template node selector to be set, got: %v", pod.Spec.NodeSelector)
+	}
+	if !metav1.IsControlledBy(pod, ch) {
+		t.Errorf("expected pod to be owned by the challenge")
+	}
+}
+
+func TestEnsurePod(t *testing.T) {
+	const createdPodKey = "createdPod"
+	tests := map[string]solverFixture{
+		"should return an existing pod if one already exists": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				pod, err := s.Solver.createPod(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				s.testResources[createdPodKey] = pod
+
+				// create a reactor that fails the test if a pod is created
+				s.Builder.FakeKubeClient().PrependReactor("create", "pods", func(action coretesting.Action) (handled bool, ret runtime.Object, err error) {
+					t.Errorf("ensurePod should not create a pod if one already exists")
+					t.Fail()
+					return false, ret, nil
+				})
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				createdPod := s.testResources[createdPodKey].(*corev1.Pod)
+				resp := args[0].(*corev1.Pod)
+				if resp == nil {
+					t.Errorf("unexpected pod = nil")
+					t.Fail()
+					return
+				}
+				if !reflect.DeepEqual(resp, createdPod) {
+					t.Errorf("Expected %v to equal %v", resp, createdPod)
+				}
+			},
+		},
+		"should create a new pod if one does not exist": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				expectedPod := s.Solver.buildPod(s.Challenge)
+				// create a reactor that checks the manifest of the created pod
+				s.Builder.FakeKubeClient().PrependReactor("create", "pods", func(action coretesting.Action) (handled bool, ret runtime.Object, err error) {
+					pod := action.(coretesting.CreateAction).GetObject().(*corev1.Pod)
+					// clear pod name as we don't know it yet in the expectedPod
+					pod.Name = ""
+					if !reflect.DeepEqual(pod, expectedPod) {
+						t.Errorf("Expected %v to equal %v", pod, expectedPod)
+					}
+					return false, ret, nil
+				})
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				resp := args[0].(*corev1.Pod)
+				err := args[1]
+				if resp == nil && err == nil {
+					t.Errorf("unexpected pod = nil")
+					t.Fail()
+					return
+				}
+				pods, err := s.Solver.podLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("unexpected error listing pods: %v", err)
+					t.Fail()
+					return
+				}
+				if len(pods) != 1 {
+					t.Errorf("unexpected %d pods in lister: %+v", len(pods), pods)
+					t.Fail()
+					return
+				}
+				if !reflect.DeepEqual(pods[0], resp) {
+					t.Errorf("Expected %v to equal %v", pods[0], resp)
+				}
+			},
+		},
+		"should clean up if multiple pods exist": {
+			Challenge: testChallenge("example.com"),
+			Err:       true,
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createPod(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				_, err = s.Solver.createPod(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				pods, err := s.Solver.podLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("error listing pods: %v", err)
+					t.Fail()
+					return
+				}
+				if len(pods) != 0 {
+					t.Errorf("expected pods to have been cleaned up, but there were %d pods left", len(pods))
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.ensurePod(context.TODO(), test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
+
+func TestGetPodsForChallenge(t *testing.T) {
+	const createdPodKey = "createdPod"
+	tests := map[string]solverFixture{
+		"should return one pod that matches": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				pod, err := s.Solver.createPod(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				s.testResources[createdPodKey] = pod
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				createdPod := s.testResources[createdPodKey].(*corev1.Pod)
+				resp := args[0].([]*corev1.Pod)
+				if len(resp) != 1 {
+					t.Errorf("expected one pod to be returned, but got %d", len(resp))
+					t.Fail()
+					return
+				}
+				if !reflect.DeepEqual(resp[0], createdPod) {
+					t.Errorf("Expected %v to equal %v", resp[0], createdPod)
+				}
+			},
+		},
+		"should not return a pod for the same challenge but different domain": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createPod(testChallenge("invaliddomain"))
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				resp := args[0].([]*corev1.Pod)
+				if len(resp) != 0 {
+					t.Errorf("expected zero pods to be returned, but got %d", len(resp))
+					t.Fail()
+					return
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.getPodsForChallenge(context.TODO(), test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
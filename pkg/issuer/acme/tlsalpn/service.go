@@ -0,0 +1,162 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+func (s *Solver) ensureService(ctx context.Context, ch *v1alpha1.Challenge) (*corev1.Service, error) {
+	log := logf.FromContext(ctx).WithName("ensureService")
+
+	log.V(logf.DebugLevel).Info("checking for existing TLSALPN01 solver services for challenge")
+	existingServices, err := s.getServicesForChallenge(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+	if len(existingServices) == 1 {
+		logf.WithRelatedResource(log, existingServices[0]).Info("found one existing TLSALPN01 solver Service for challenge resource")
+		return existingServices[0], nil
+	}
+	if len(existingServices) > 1 {
+		log.Info("multiple challenge solver services found for challenge. cleaning up all existing services.")
+		err := s.cleanupServices(ctx, ch)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("multiple existing challenge solver services found and cleaned up. retrying challenge sync")
+	}
+
+	log.Info("creating TLSALPN01 challenge solver service")
+	return s.createService(ch)
+}
+
+// getServicesForChallenge returns a list of services that were created to
+// solve the given challenge
+func (s *Solver) getServicesForChallenge(ctx context.Context, ch *v1alpha1.Challenge) ([]*corev1.Service, error) {
+	log := logf.FromContext(ctx)
+
+	selector, err := selectorForChallenge(ch)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceList, err := s.serviceLister.Services(ch.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var relevantServices []*corev1.Service
+	for _, service := range serviceList {
+		if !metav1.IsControlledBy(service, ch) {
+			logf.WithRelatedResource(log, service).Info("found existing solver service for this challenge resource, however " +
+				"it does not have an appropriate OwnerReference referencing this challenge. Skipping it altogether.")
+			continue
+		}
+		relevantServices = append(relevantServices, service)
+	}
+
+	return relevantServices, nil
+}
+
+// createService will create the service required to solve this challenge
+// in the target API server.
+func (s *Solver) createService(ch *v1alpha1.Challenge) (*corev1.Service, error) {
+	svc, err := buildService(ch)
+	if err != nil {
+		return nil, err
+	}
+	return s.Client.CoreV1().Services(ch.Namespace).Create(svc)
+}
+
+// buildService builds a Service routing connections on the tls-alpn-01
+// challenge port to the challenge solver pod.
+func buildService(ch *v1alpha1.Challenge) (*corev1.Service, error) {
+	cfg, err := tlsALPNCfgForChallenge(ch)
+	if err != nil {
+		return nil, err
+	}
+
+	podLabels := podLabels(ch)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "cm-acme-tls-alpn-solver-",
+			Namespace:    ch.Namespace,
+			Labels:       podLabels,
+			Annotations: map[string]string{
+				"auth.istio.io/443": "NONE",
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "https",
+					Port:       challengePort,
+					TargetPort: intstr.FromInt(acmeSolverListenPort),
+				},
+			},
+			Selector: podLabels,
+		},
+	}
+
+	// if a serviceType is set, override our default (NodePort)
+	if cfg.ServiceType != "" {
+		service.Spec.Type = cfg.ServiceType
+	}
+
+	// expose the service on a fixed node port if one is set, so that it can
+	// be targeted by a load balancer outside of the cluster
+	if cfg.NodePort != 0 {
+		service.Spec.Ports[0].NodePort = cfg.NodePort
+	}
+
+	return service, nil
+}
+
+func (s *Solver) cleanupServices(ctx context.Context, ch *v1alpha1.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupServices")
+
+	services, err := s.getServicesForChallenge(ctx, ch)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, service := range services {
+		log := logf.WithRelatedResource(log, service).V(logf.DebugLevel)
+		log.Info("deleting service resource")
+
+		err := s.Client.CoreV1().Services(service.Namespace).Delete(service.Name, nil)
+		if err != nil {
+			log.Info("failed to delete service resource", "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		log.Info("successfully deleted service resource")
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	coretesting "k8s.io/client-go/testing"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestBuildService(t *testing.T) {
+	tests := map[string]struct {
+		solver           *v1alpha1.ACMEChallengeSolver
+		expectedType     corev1.ServiceType
+		expectedNodePort int32
+		err              bool
+	}{
+		"should default to a NodePort service": {
+			solver:       &v1alpha1.ACMEChallengeSolver{TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{}},
+			expectedType: corev1.ServiceTypeNodePort,
+		},
+		"should use the configured node port": {
+			solver: &v1alpha1.ACMEChallengeSolver{TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+				NodePort: 30443,
+			}},
+			expectedType:     corev1.ServiceTypeNodePort,
+			expectedNodePort: 30443,
+		},
+		"should use the configured service type": {
+			solver: &v1alpha1.ACMEChallengeSolver{TLSALPN01: &v1alpha1.ACMEChallengeSolverTLSALPN01{
+				ServiceType: corev1.ServiceTypeClusterIP,
+			}},
+			expectedType: corev1.ServiceTypeClusterIP,
+		},
+		"should error if no tlsalpn01 config is present": {
+			solver: &v1alpha1.ACMEChallengeSolver{},
+			err:    true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := &v1alpha1.Challenge{
+				Spec: v1alpha1.ChallengeSpec{
+					DNSName: "example.com",
+					Token:   "token",
+					Solver:  test.solver,
+				},
+			}
+			svc, err := buildService(ch)
+			if test.err {
+				if err == nil {
+					t.Errorf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if svc.Spec.Type != test.expectedType {
+				t.Errorf("expected service type %q, got %q", test.expectedType, svc.Spec.Type)
+			}
+			if len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].Port != challengePort ||
+				svc.Spec.Ports[0].TargetPort != intstr.FromInt(acmeSolverListenPort) {
+				t.Errorf("expected port %d to be routed to %d, got: %+v", challengePort, acmeSolverListenPort, svc.Spec.Ports)
+			}
+			if svc.Spec.Ports[0].NodePort != test.expectedNodePort {
+				t.Errorf("expected node port %d, got %d", test.expectedNodePort, svc.Spec.Ports[0].NodePort)
+			}
+			for k, v := range podLabels(ch) {
+				if svc.Spec.Selector[k] != v {
+					t.Errorf("expected service selector to contain %s=%s, got: %v", k, v, svc.Spec.Selector)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureService(t *testing.T) {
+	const createdServiceKey = "createdService"
+	tests := map[string]solverFixture{
+		"should return an existing service if one already exists": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				svc, err := s.Solver.createService(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				s.testResources[createdServiceKey] = svc
+
+				// create a reactor that fails the test if a service is created
+				s.Builder.FakeKubeClient().PrependReactor("create", "services", func(action coretesting.Action) (handled bool, ret runtime.Object, err error) {
+					t.Errorf("ensureService should not create a service if one already exists")
+					t.Fail()
+					return false, ret, nil
+				})
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				createdService := s.testResources[createdServiceKey].(*corev1.Service)
+				resp := args[0].(*corev1.Service)
+				if resp == nil {
+					t.Errorf("unexpected service = nil")
+					t.Fail()
+					return
+				}
+				if !reflect.DeepEqual(resp, createdService) {
+					t.Errorf("Expected %v to equal %v", resp, createdService)
+				}
+			},
+		},
+		"should create a new service if one does not exist": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				expectedService, err := buildService(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				// create a reactor that checks the manifest of the created service
+				s.Builder.FakeKubeClient().PrependReactor("create", "services", func(action coretesting.Action) (handled bool, ret runtime.Object, err error) {
+					svc := action.(coretesting.CreateAction).GetObject().(*corev1.Service)
+					// clear service name as we don't know it yet in the expectedService
+					svc.Name = ""
+					if !reflect.DeepEqual(svc, expectedService) {
+						t.Errorf("Expected %v to equal %v", svc, expectedService)
+					}
+					return false, ret, nil
+				})
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				resp := args[0].(*corev1.Service)
+				err := args[1]
+				if resp == nil && err == nil {
+					t.Errorf("unexpected service = nil")
+					t.Fail()
+					return
+				}
+				services, err := s.Solver.serviceLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("unexpected error listing services: %v", err)
+					t.Fail()
+					return
+				}
+				if len(services) != 1 {
+					t.Errorf("unexpected %d services in lister: %+v", len(services), services)
+					t.Fail()
+					return
+				}
+				if !reflect.DeepEqual(services[0], resp) {
+					t.Errorf("Expected %v to equal %v", services[0], resp)
+				}
+				if services[0].Spec.Type != corev1.ServiceTypeNodePort {
+					t.Errorf("expected service of type %q, got %q", corev1.ServiceTypeNodePort, services[0].Spec.Type)
+				}
+			},
+		},
+		"should clean up if multiple services exist": {
+			Challenge: testChallenge("example.com"),
+			Err:       true,
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createService(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				_, err = s.Solver.createService(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				services, err := s.Solver.serviceLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("error listing services: %v", err)
+					t.Fail()
+					return
+				}
+				if len(services) != 0 {
+					t.Errorf("expected services to have been cleaned up, but there were %d services left", len(services))
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.ensureService(context.TODO(), test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
+
+func TestGetServicesForChallenge(t *testing.T) {
+	const createdServiceKey = "createdService"
+	tests := map[string]solverFixture{
+		"should return one service that matches": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				svc, err := s.Solver.createService(s.Challenge)
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.testResources[createdServiceKey] = svc
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				createdService := s.testResources[createdServiceKey].(*corev1.Service)
+				resp := args[0].([]*corev1.Service)
+				if len(resp) != 1 {
+					t.Errorf("expected one service to be returned, but got %d", len(resp))
+					t.Fail()
+					return
+				}
+				if !reflect.DeepEqual(resp[0], createdService) {
+					t.Errorf("Expected %v to equal %v", resp[0], createdService)
+				}
+			},
+		},
+		"should not return a service for the same challenge but different domain": {
+			Challenge: testChallenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createService(testChallenge("invaliddomain"))
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				resp := args[0].([]*corev1.Service)
+				if len(resp) != 0 {
+					t.Errorf("expected zero services to be returned, but got %d", len(resp))
+					t.Fail()
+					return
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.getServicesForChallenge(context.TODO(), test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+type TLSALPN01Solver struct {
+	ListenPort int
+
+	Domain string
+	Key    string
+}
+
+// Listen will accept TLS connections on the configured port and answer those
+// negotiating the acme-tls/1 protocol for the configured domain with a
+// validation certificate for the challenge key.
+func (s *TLSALPN01Solver) Listen(ctx context.Context) error {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", s.ListenPort))
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, l)
+}
+
+// Serve will answer tls-alpn-01 challenge connections accepted on the given
+// listener until the context is cancelled.
+func (s *TLSALPN01Solver) Serve(ctx context.Context, l net.Listener) error {
+	log := logf.FromContext(ctx)
+	log.Info("starting listener",
+		"expected_domain", s.Domain,
+		"expected_key", s.Key,
+		"listen_address", l.Addr().String(),
+	)
+
+	cert, err := ChallengeCertificate(s.Domain, s.Key)
+	if err != nil {
+		return fmt.Errorf("error generating validation certificate: %v", err)
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{ACMETLS1Protocol},
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			log := log.WithValues("server_name", hello.ServerName, "protocols", hello.SupportedProtos)
+			log.Info("validating request")
+			if !containsProtocol(hello.SupportedProtos, ACMETLS1Protocol) {
+				log.Info("client did not offer the acme-tls/1 protocol")
+				return nil, fmt.Errorf("client did not offer the %s protocol", ACMETLS1Protocol)
+			}
+			if hello.ServerName != s.Domain {
+				log.Info("invalid server name", "expected_server_name", s.Domain)
+				return nil, fmt.Errorf("unexpected server name %q", hello.ServerName)
+			}
+			log.Info("got successful challenge request, presenting validation certificate")
+			return &cert, nil
+		},
+	}
+
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				return err
+			}
+		}
+		go func() {
+			defer conn.Close()
+			tlsConn := tls.Server(conn, config)
+			tlsConn.SetDeadline(time.Now().Add(time.Second * 10))
+			if err := tlsConn.Handshake(); err != nil {
+				log.V(logf.DebugLevel).Info("TLS handshake failed", "error", err)
+			}
+		}()
+	}
+}
+
+// ChallengeCertificate builds a self-signed validation certificate for the
+// given domain, containing the acmeIdentifier extension with the SHA-256
+// digest of the given key authorization as defined in RFC 8737 section 3.
+func ChallengeCertificate(domain, keyAuth string) (tls.Certificate, error) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	digest := sha256.Sum256([]byte(keyAuth))
+	extValue, err := asn1.Marshal(digest[:])
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour * 24),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		ExtraExtensions: []pkix.Extension{
+			{
+				Id:       idPeACMEIdentifier,
+				Critical: true,
+				Value:    extValue,
+			},
+		},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &pk.PublicKey, pk)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: pk}, nil
+}
+
+// VerifyChallengeCertificate checks that the given certificate is a valid
+// tls-alpn-01 validation certificate for the given domain and key
+// authorization.
+func VerifyChallengeCertificate(cert *x509.Certificate, domain, keyAuth string) error {
+	if len(cert.DNSNames) != 1 || cert.DNSNames[0] != domain {
+		return fmt.Errorf("certificate DNS names %v do not match domain %q", cert.DNSNames, domain)
+	}
+
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(idPeACMEIdentifier) {
+			continue
+		}
+		if !ext.Critical {
+			return fmt.Errorf("acmeIdentifier extension is not marked critical")
+		}
+		var presented []byte
+		if rest, err := asn1.Unmarshal(ext.Value, &presented); err != nil || len(rest) > 0 {
+			return fmt.Errorf("invalid acmeIdentifier extension value")
+		}
+		digest := sha256.Sum256([]byte(keyAuth))
+		if !bytes.Equal(presented, digest[:]) {
+			return fmt.Errorf("presented key authorization digest (%x) did not match expected (%x)", presented, digest)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("certificate does not contain the acmeIdentifier extension")
+}
+
+func containsProtocol(protos []string, proto string) bool {
+	for _, p := range protos {
+		if p == proto {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+)
+
+// validate performs a tls-alpn-01 validation against the given address in
+// the same way as an ACME server, returning an error if validation fails.
+func validate(addr, domain, keyAuth string, protos []string) error {
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		ServerName:         domain,
+		NextProtos:         protos,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return VerifyChallengeCertificate(conn.ConnectionState().PeerCertificates[0], domain, keyAuth)
+}
+
+func TestTLSALPN01Solver(t *testing.T) {
+	const (
+		domain  = "example.com"
+		keyAuth = "token.thumbprint"
+	)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	s := &TLSALPN01Solver{Domain: domain, Key: keyAuth}
+	go func() { errCh <- s.Serve(ctx, l) }()
+	defer func() {
+		cancel()
+		if err := <-errCh; err != nil {
+			t.Errorf("unexpected error from Serve: %v", err)
+		}
+	}()
+
+	tests := map[string]struct {
+		domain  string
+		keyAuth string
+		protos  []string
+		err     bool
+	}{
+		"validates a challenge for the configured domain and key": {
+			domain:  domain,
+			keyAuth: keyAuth,
+			protos:  []string{ACMETLS1Protocol},
+		},
+		"fails validation of a different key authorization": {
+			domain:  domain,
+			keyAuth: "token.other",
+			protos:  []string{ACMETLS1Protocol},
+			err:     true,
+		},
+		"rejects connections for a different domain": {
+			domain:  "other.example.com",
+			keyAuth: keyAuth,
+			protos:  []string{ACMETLS1Protocol},
+			err:     true,
+		},
+		"rejects connections not offering the acme-tls/1 protocol": {
+			domain:  domain,
+			keyAuth: keyAuth,
+			protos:  []string{"h2", "http/1.1"},
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validate(l.Addr().String(), test.domain, test.keyAuth, test.protos)
+			if test.err != (err != nil) {
+				t.Errorf("expected error %t, got: %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyChallengeCertificate(t *testing.T) {
+	cert, err := ChallengeCertificate("example.com", "token.thumbprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	challengeCert, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		cert   *x509.Certificate
+		domain string
+		err    bool
+	}{
+		"verifies a challenge certificate": {
+			cert:   challengeCert,
+			domain: "example.com",
+		},
+		"rejects a challenge certificate for a different domain": {
+			cert:   challengeCert,
+			domain: "other.example.com",
+			err:    true,
+		},
+		"rejects a certificate without the acmeIdentifier extension": {
+			cert:   &x509.Certificate{DNSNames: []string{"example.com"}},
+			domain: "example.com",
+			err:    true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := VerifyChallengeCertificate(test.cert, test.domain, "token.thumbprint")
+			if test.err != (err != nil) {
+				t.Errorf("expected error %t, got: %v", test.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/controller"
+	"github.com/leki75/cert-manager/pkg/issuer/acme/tlsalpn/solver"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+const (
+	// TLSALPN01Timeout is the max amount of time to wait for a TLS-ALPN-01
+	// challenge to succeed
+	TLSALPN01Timeout = time.Minute * 15
+	// acmeSolverListenPort is the port acmesolver should listen on
+	acmeSolverListenPort = 8443
+	// challengePort is the port the ACME server connects to in order to
+	// validate tls-alpn-01 challenges
+	challengePort = 443
+
+	domainLabelKey               = "certmanager.k8s.io/acme-tls-alpn-domain"
+	tokenLabelKey                = "certmanager.k8s.io/acme-tls-alpn-token"
+	solverIdentificationLabelKey = "certmanager.k8s.io/acme-tls-alpn01-solver"
+)
+
+var (
+	challengeGvk = v1alpha1.SchemeGroupVersion.WithKind("Challenge")
+)
+
+// Solver is an implementation of the acme tls-alpn-01 challenge solver protocol
+type Solver struct {
+	*controller.Context
+
+	podLister     corev1listers.PodLister
+	serviceLister corev1listers.ServiceLister
+
+	testReachability reachabilityTest
+	requiredPasses   int
+}
+
+type reachabilityTest func(ctx context.Context, addr, domain, key string) error
+
+// NewSolver returns a new ACME TLS-ALPN-01 solver for the given controller
+// context.
+func NewSolver(ctx *controller.Context) *Solver {
+	return &Solver{
+		Context:          ctx,
+		podLister:        ctx.KubeSharedInformerFactory.Core().V1().Pods().Lister(),
+		serviceLister:    ctx.KubeSharedInformerFactory.Core().V1().Services().Lister(),
+		testReachability: testReachability,
+		requiredPasses:   5,
+	}
+}
+
+func tlsALPN01LogCtx(ctx context.Context) context.Context {
+	return logf.NewContext(ctx, nil, "tlsalpn01")
+}
+
+func tlsALPNCfgForChallenge(ch *v1alpha1.Challenge) (*v1alpha1.ACMEChallengeSolverTLSALPN01, error) {
+	if ch.Spec.Solver == nil || ch.Spec.Solver.TLSALPN01 == nil {
+		return nil, fmt.Errorf("no TLSALPN01 config found on challenge. " +
+			"Ensure solvers[].tlsalpn01 is specified on your issuer resource")
+	}
+	return ch.Spec.Solver.TLSALPN01, nil
+}
+
+// Present will realise the resources required to solve the given TLS-ALPN-01
+// challenge validation in the apiserver. If those resources already exist, it
+// will return nil (i.e. this function is idempotent).
+func (s *Solver) Present(ctx context.Context, issuer v1alpha1.GenericIssuer, ch *v1alpha1.Challenge) error {
+	ctx = tlsALPN01LogCtx(ctx)
+
+	_, podErr := s.ensurePod(ctx, ch)
+	_, svcErr := s.ensureService(ctx, ch)
+	return utilerrors.NewAggregate([]error{podErr, svcErr})
+}
+
+func (s *Solver) Check(ctx context.Context, issuer v1alpha1.GenericIssuer, ch *v1alpha1.Challenge) error {
+	ctx = logf.NewContext(tlsALPN01LogCtx(ctx), nil, "selfCheck")
+	log := logf.FromContext(ctx)
+
+	// Present is idempotent and the state of the system may have changed
+	// since present was called by the controllers (killed pods, drained nodes)
+	// Call present again to be certain.
+	if s.podLister != nil && s.serviceLister != nil {
+		log.V(logf.DebugLevel).Info("calling Present function before running self check to ensure required resources exist")
+		err := s.Present(ctx, issuer, ch)
+		if err != nil {
+			log.V(logf.DebugLevel).Info("failed to call Present function", "error", err)
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, TLSALPN01Timeout)
+	defer cancel()
+	addr := net.JoinHostPort(ch.Spec.DNSName, fmt.Sprintf("%d", challengePort))
+	log = log.WithValues("address", addr)
+	ctx = logf.NewContext(ctx, log)
+
+	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
+	for i := 0; i < s.requiredPasses; i++ {
+		err := s.testReachability(ctx, addr, ch.Spec.DNSName, ch.Spec.Key)
+		if err != nil {
+			return err
+		}
+		log.V(logf.DebugLevel).Info("reachability test passed, re-checking in 2s time")
+		time.Sleep(time.Second * 2)
+	}
+
+	log.V(logf.DebugLevel).Info("self check succeeded")
+
+	return nil
+}
+
+// CleanUp will ensure the created service and pod are clean/deleted of any
+// cert-manager created data.
+func (s *Solver) CleanUp(ctx context.Context, issuer v1alpha1.GenericIssuer, ch *v1alpha1.Challenge) error {
+	var errs []error
+	errs = append(errs, s.cleanupPods(ctx, ch))
+	errs = append(errs, s.cleanupServices(ctx, ch))
+	return utilerrors.NewAggregate(errs)
+}
+
+// testReachability will attempt to connect to 'addr' negotiating the
+// acme-tls/1 protocol for 'domain', and check that the presented certificate
+// is a valid validation certificate for 'key'
+func testReachability(ctx context.Context, addr, domain, key string) error {
+	log := logf.FromContext(ctx)
+	log.V(logf.DebugLevel).Info("performing TLSALPN01 reachability check")
+
+	dialer := &net.Dialer{Timeout: time.Second * 10}
+	conn, err := tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{
+		ServerName: domain,
+		NextProtos: []string{solver.ACMETLS1Protocol},
+		// the validation certificate is self-signed
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to perform self check TLS handshake", "error", err)
+		return fmt.Errorf("failed to perform self check TLS handshake with '%s': %v", addr, err)
+	}
+	defer conn.Close()
+
+	state := conn.ConnectionState()
+	if state.NegotiatedProtocol != solver.ACMETLS1Protocol {
+		log.V(logf.DebugLevel).Info("server did not negotiate the acme-tls/1 protocol", "protocol", state.NegotiatedProtocol)
+		return fmt.Errorf("wrong protocol '%s' negotiated, expected '%s'", state.NegotiatedProtocol, solver.ACMETLS1Protocol)
+	}
+	if len(state.PeerCertificates) == 0 {
+		return fmt.Errorf("no certificate presented by server")
+	}
+
+	if err := solver.VerifyChallengeCertificate(state.PeerCertificates[0], domain, key); err != nil {
+		log.V(logf.DebugLevel).Info("certificate presented by server is not valid for challenge", "error", err)
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("reachability test succeeded")
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer/acme/tlsalpn/solver"
+)
+
+// countReachabilityTestCalls is a wrapper function that allows us to count the number
+// of calls to a reachabilityTest.
+func countReachabilityTestCalls(counter *int, t reachabilityTest) reachabilityTest {
+	return func(ctx context.Context, addr, domain, key string) error {
+		*counter++
+		return t(ctx, addr, domain, key)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	type testT struct {
+		name             string
+		reachabilityTest reachabilityTest
+		expectedErr      bool
+	}
+	tests := []testT{
+		{
+			name: "should pass",
+			reachabilityTest: func(context.Context, string, string, string) error {
+				return nil
+			},
+			expectedErr: false,
+		},
+		{
+			name: "should error",
+			reachabilityTest: func(context.Context, string, string, string) error {
+				return fmt.Errorf("failed")
+			},
+			expectedErr: true,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			requiredCallsForPass := 2
+			s := Solver{
+				testReachability: countReachabilityTestCalls(&calls, test.reachabilityTest),
+				requiredPasses:   requiredCallsForPass,
+			}
+
+			err := s.Check(context.Background(), nil, &v1alpha1.Challenge{})
+			if err != nil && !test.expectedErr {
+				t.Errorf("Expected Check to return non-nil error, but got %v", err)
+				return
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("Expected error from Check, but got none")
+				return
+			}
+			if !test.expectedErr && calls != requiredCallsForPass {
+				t.Errorf("Expected Wait to verify reachability test passes %d times, but only checked %d", requiredCallsForPass, calls)
+				return
+			}
+		})
+	}
+}
+
+func TestReachability(t *testing.T) {
+	const (
+		domain = "example.com"
+		key    = "token.thumbprint"
+	)
+
+	// run a challenge solver on a local port to stand in for the solver pod
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &solver.TLSALPN01Solver{Domain: domain, Key: key}
+	go s.Serve(ctx, l)
+
+	tests := map[string]struct {
+		domain string
+		key    string
+		err    bool
+	}{
+		"should pass if the solver presents a certificate for the key": {
+			domain: domain,
+			key:    key,
+		},
+		"should error if the solver presents a certificate for a different key": {
+			domain: domain,
+			key:    "token.other",
+			err:    true,
+		},
+		"should error if the solver does not answer for the domain": {
+			domain: "other.example.com",
+			key:    key,
+			err:    true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := testReachability(context.Background(), l.Addr().String(), test.domain, test.key)
+			if test.err != (err != nil) {
+				t.Errorf("expected error %t, got: %v", test.err, err)
+			}
+		})
+	}
+}
@@ -47,11 +47,12 @@ func (c *CA) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.Issu
 	log := logf.FromContext(ctx, "issue")
 	log = logf.WithRelatedResourceName(log, crt.Spec.SecretName, crt.Namespace, "Secret")
 
-	// get a copy of the existing/currently issued Certificate's private key
-	signeeKey, err := kube.SecretTLSKey(ctx, c.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	// get a copy of the existing/currently issued Certificate's private key,
+	// unless the Certificate's key rotation policy requires a new one
+	signeeKey, err := kube.SecretTLSKeyForCertificate(ctx, c.secretsLister, crt)
 	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
 		log.Info("generating new private key")
-		// if one does not already exist, generate a new one
+		// if one does not already exist or must be rotated, generate a new one
 		signeeKey, err = pki.GeneratePrivateKeyForCertificate(crt)
 		if err != nil {
 			log.Error(err, "error generating private key")
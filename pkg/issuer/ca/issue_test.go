@@ -24,6 +24,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -122,6 +123,18 @@ func certificatesFieldsSetCheck(expectedCA []byte) func(t *testing.T, s *caFixtu
 	}
 }
 
+func privateKeyCheck(expectedCA, existingKey []byte, expectRotated bool) func(t *testing.T, s *caFixture, args ...interface{}) {
+	return func(t *testing.T, s *caFixture, args ...interface{}) {
+		allFieldsSetCheck(expectedCA)(t, s, args...)
+
+		resp := args[1].(*issuer.IssueResponse)
+		rotated := !bytes.Equal(resp.PrivateKey, existingKey)
+		if rotated != expectRotated {
+			t.Errorf("expected private key rotated=%t but got rotated=%t", expectRotated, rotated)
+		}
+	}
+}
+
 func TestIssue(t *testing.T) {
 	// Build root RSA CA
 	rsaPK := generateRSAPrivateKey(t)
@@ -169,6 +182,69 @@ func TestIssue(t *testing.T) {
 		},
 	}
 
+	// Build root Ed25519 CA
+	ed25519PK, err := pki.GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Errorf("failed to generate private key: %v", err)
+		t.FailNow()
+	}
+	rootEd25519Crt := gen.Certificate("test-root-ca",
+		gen.SetCertificateCommonName("root-ca"),
+		gen.SetCertificateIsCA(true),
+		gen.SetCertificateKeyAlgorithm(v1alpha1.Ed25519KeyAlgorithm),
+	)
+	ed25519PKBytes, err := pki.EncodePrivateKey(ed25519PK, rootEd25519Crt.Spec.KeyEncoding)
+	if err != nil {
+		t.Errorf("Error encoding private key: %v", err)
+		t.FailNow()
+	}
+	// generate a self signed root ca valid for 60d
+	_, ed25519PEMCert := generateSelfSignedCert(t, rootEd25519Crt, ed25519PK, time.Hour*24*60)
+	rootEd25519CASecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "root-ca-secret",
+			Namespace: gen.DefaultTestNamespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: ed25519PKBytes,
+			corev1.TLSCertKey:       ed25519PEMCert,
+		},
+	}
+
+	// Build an existing certificate for the Certificate being issued
+	existingPK := generateRSAPrivateKey(t)
+	existingPKBytes := pki.EncodePKCS1PrivateKey(existingPK)
+	existingCrt := gen.Certificate("test-crt",
+		gen.SetCertificateSecretName("crt-output"),
+		gen.SetCertificateCommonName("testing-cn"),
+	)
+	_, existingPEMCert := generateSelfSignedCert(t, existingCrt, existingPK, time.Hour*24*60)
+	existingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "crt-output",
+			Namespace: gen.DefaultTestNamespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: existingPKBytes,
+			corev1.TLSCertKey:       existingPEMCert,
+		},
+	}
+
+	// Build a secret containing only a temporary certificate
+	tempTemplate, err := pki.GenerateTemplate(existingCrt)
+	if err != nil {
+		t.Errorf("error generating template: %v", err)
+		t.FailNow()
+	}
+	tempTemplate.SerialNumber = big.NewInt(pki.TemporaryCertificateSerialNumber)
+	tempPEMCert, _, err := pki.SignCertificate(tempTemplate, tempTemplate, existingPK.Public(), existingPK)
+	if err != nil {
+		t.Errorf("error signing temporary certificate: %v", err)
+		t.FailNow()
+	}
+	existingTempSecret := existingSecret.DeepCopy()
+	existingTempSecret.Data[corev1.TLSCertKey] = tempPEMCert
+
 	tests := map[string]caFixture{
 		"sign a Certificate and generate a new RSA private key": {
 			Issuer: gen.Issuer("ca-issuer",
@@ -221,6 +297,81 @@ func TestIssue(t *testing.T) {
 			CheckFn: allFieldsSetCheck(ecdsaPEMCert),
 			Err:     false,
 		},
+		"sign a Certificate and generate a new Ed25519 private key using Ed25519 issuer": {
+			Issuer: gen.Issuer("ca-issuer",
+				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+			),
+			Certificate: gen.Certificate("test-crt",
+				gen.SetCertificateSecretName("crt-output"),
+				gen.SetCertificateCommonName("testing-cn"),
+				gen.SetCertificateKeyAlgorithm(v1alpha1.Ed25519KeyAlgorithm),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootEd25519CASecret},
+				CertManagerObjects: []runtime.Object{},
+			},
+			CheckFn: allFieldsSetCheck(ed25519PEMCert),
+			Err:     false,
+		},
+		"sign a Certificate and generate a new RSA private key using Ed25519 issuer": {
+			Issuer: gen.Issuer("ca-issuer",
+				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+			),
+			Certificate: gen.Certificate("test-crt",
+				gen.SetCertificateSecretName("crt-output"),
+				gen.SetCertificateCommonName("testing-cn"),
+				gen.SetCertificateKeyAlgorithm(v1alpha1.RSAKeyAlgorithm),
+				gen.SetCertificateKeySize(2048),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootEd25519CASecret},
+				CertManagerObjects: []runtime.Object{},
+			},
+			CheckFn: allFieldsSetCheck(ed25519PEMCert),
+			Err:     false,
+		},
+		"reuse the existing private key if keyRotationPolicy is Never": {
+			Issuer: gen.Issuer("ca-issuer",
+				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+			),
+			Certificate: gen.CertificateFrom(existingCrt,
+				gen.SetCertificateKeyRotationPolicy(v1alpha1.KeyRotationPolicyNever),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret, existingSecret},
+				CertManagerObjects: []runtime.Object{},
+			},
+			CheckFn: privateKeyCheck(rsaPEMCert, existingPKBytes, false),
+			Err:     false,
+		},
+		"generate a new private key if keyRotationPolicy is Always": {
+			Issuer: gen.Issuer("ca-issuer",
+				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+			),
+			Certificate: gen.CertificateFrom(existingCrt,
+				gen.SetCertificateKeyRotationPolicy(v1alpha1.KeyRotationPolicyAlways),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret, existingSecret},
+				CertManagerObjects: []runtime.Object{},
+			},
+			CheckFn: privateKeyCheck(rsaPEMCert, existingPKBytes, true),
+			Err:     false,
+		},
+		"reuse a private key only used for a temporary certificate if keyRotationPolicy is Always": {
+			Issuer: gen.Issuer("ca-issuer",
+				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+			),
+			Certificate: gen.CertificateFrom(existingCrt,
+				gen.SetCertificateKeyRotationPolicy(v1alpha1.KeyRotationPolicyAlways),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret, existingTempSecret},
+				CertManagerObjects: []runtime.Object{},
+			},
+			CheckFn: privateKeyCheck(rsaPEMCert, existingPKBytes, false),
+			Err:     false,
+		},
 	}
 
 	for name, test := range tests {
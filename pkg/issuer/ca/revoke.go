@@ -0,0 +1,31 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/issuer"
+)
+
+// Revoke is not supported by the CA issuer, as it does not publish
+// certificate revocation lists.
+func (c *CA) Revoke(ctx context.Context, crt *v1alpha1.Certificate, cert *x509.Certificate, reason v1alpha1.RevocationReason) error {
+	return issuer.ErrRevocationNotSupported
+}
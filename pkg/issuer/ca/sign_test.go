@@ -131,6 +131,39 @@ func TestSign(t *testing.T) {
 			CheckFn: noPrivateKeyFieldsSetCheck(rsaPEMCert),
 			Err:     false,
 		},
+		"sign a CertificateRequest with client auth usages": {
+			Issuer: gen.Issuer("ca-issuer",
+				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
+			),
+			CertificateRequest: gen.CertificateRequest("test-cr",
+				gen.SetCertificateRequestCSR(caCSR),
+				gen.SetCertificateRequestKeyUsages(v1alpha1.UsageDigitalSignature, v1alpha1.UsageClientAuth),
+			),
+			Builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rootRSACASecret},
+				CertManagerObjects: []runtime.Object{},
+			},
+			CheckFn: func(t *testing.T, s *caFixture, args ...interface{}) {
+				noPrivateKeyFieldsSetCheck(rsaPEMCert)(t, s, args...)
+
+				resp := args[1].(*issuer.IssueResponse)
+				if resp == nil {
+					return
+				}
+				cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+				if err != nil {
+					t.Errorf("failed to decode signed certificate: %v", err)
+					return
+				}
+				if cert.KeyUsage != x509.KeyUsageDigitalSignature {
+					t.Errorf("unexpected key usage, exp=%v got=%v", x509.KeyUsageDigitalSignature, cert.KeyUsage)
+				}
+				if len(cert.ExtKeyUsage) != 1 || cert.ExtKeyUsage[0] != x509.ExtKeyUsageClientAuth {
+					t.Errorf("unexpected extended key usages, exp=%v got=%v", []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+				}
+			},
+			Err: false,
+		},
 		"fail to find CA tls key pair": {
 			Issuer: gen.Issuer("ca-issuer",
 				gen.SetIssuerCA(v1alpha1.CAIssuer{SecretName: "root-ca-secret"}),
@@ -18,18 +18,22 @@ package fake
 
 import (
 	"context"
+	"crypto/x509"
 
 	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
 )
 
 type Issuer struct {
-	FakeSetup func(context.Context) error
-	FakeIssue func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error)
-	FakeSign  func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error)
+	FakeSetup       func(context.Context) error
+	FakeIssue       func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error)
+	FakeSign        func(context.Context, *cmapi.CertificateRequest) (*issuer.IssueResponse, error)
+	FakeRevoke      func(context.Context, *cmapi.Certificate, *x509.Certificate, cmapi.RevocationReason) error
+	FakeRenewalInfo func(context.Context, *cmapi.Certificate, *x509.Certificate) (*cmapi.CertificateRenewalInfo, error)
 }
 
 var _ issuer.Interface = &Issuer{}
+var _ issuer.RenewalInfoProvider = &Issuer{}
 
 // Setup initialises the issuer. This may include registering accounts with
 // a service, creating a CA and storing it somewhere, or verifying
@@ -49,3 +53,18 @@ func (i *Issuer) Issue(ctx context.Context, crt *cmapi.Certificate) (*issuer.Iss
 func (i *Issuer) Sign(ctx context.Context, cr *cmapi.CertificateRequest) (*issuer.IssueResponse, error) {
 	return i.FakeSign(ctx, cr)
 }
+
+// Revoke attempts to revoke the given certificate, issued for the
+// certificate resource given, with the provided reason.
+func (i *Issuer) Revoke(ctx context.Context, crt *cmapi.Certificate, cert *x509.Certificate, reason cmapi.RevocationReason) error {
+	return i.FakeRevoke(ctx, crt, cert, reason)
+}
+
+// RenewalInfo returns the renewal window suggested for the given certificate.
+// If FakeRenewalInfo is not set, ErrRenewalInfoNotSupported is returned.
+func (i *Issuer) RenewalInfo(ctx context.Context, crt *cmapi.Certificate, cert *x509.Certificate) (*cmapi.CertificateRenewalInfo, error) {
+	if i.FakeRenewalInfo == nil {
+		return nil, issuer.ErrRenewalInfoNotSupported
+	}
+	return i.FakeRenewalInfo(ctx, crt, cert)
+}
@@ -19,6 +19,11 @@ package issuer
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
 	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	cmlisters "github.com/leki75/cert-manager/pkg/client/listers/certmanager/v1alpha1"
 )
@@ -69,3 +74,38 @@ func (h *helperImpl) GetGenericIssuer(ref cmapi.ObjectReference, ns string) (cma
 		return nil, fmt.Errorf(`invalid value %q for issuerRef.kind. Must be empty, %q or %q`, ref.Kind, cmapi.IssuerKind, cmapi.ClusterIssuerKind)
 	}
 }
+
+// NamespaceAllowed returns true if resources in the given namespace may
+// reference the given issuer. Only ClusterIssuers may restrict the namespaces
+// they are referenced from, using their namespaceSelector. The namespace is
+// only read from the lister if the selector has to be evaluated against its
+// labels.
+func NamespaceAllowed(namespaceLister corelisters.NamespaceLister, iss cmapi.GenericIssuer, namespace string) (bool, error) {
+	if _, ok := iss.(*cmapi.ClusterIssuer); !ok {
+		return true, nil
+	}
+	sel := iss.GetSpec().NamespaceSelector
+	if sel == nil {
+		return true, nil
+	}
+	for _, name := range sel.MatchNames {
+		if name == namespace {
+			return true, nil
+		}
+	}
+	if sel.Selector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sel.Selector)
+	if err != nil {
+		return false, err
+	}
+	ns, err := namespaceLister.Get(namespace)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
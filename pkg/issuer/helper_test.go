@@ -20,7 +20,11 @@ import (
 	"reflect"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/controller/test"
@@ -108,3 +112,70 @@ func TestGetGenericIssuer(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespaceAllowed(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, ns := range []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"team": "dev"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod"}},
+	} {
+		if err := indexer.Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+	namespaceLister := corelisters.NewNamespaceLister(indexer)
+
+	selector := v1alpha1.IssuerNamespaceSelector{
+		MatchNames: []string{"payments"},
+		Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"team": "dev"}},
+	}
+
+	tests := map[string]struct {
+		issuer    v1alpha1.GenericIssuer
+		namespace string
+		expected  bool
+	}{
+		"should allow all namespaces if no selector is set": {
+			issuer:    gen.ClusterIssuer("test"),
+			namespace: "prod",
+			expected:  true,
+		},
+		"should allow a namespace named in matchNames": {
+			issuer:    gen.ClusterIssuer("test", gen.SetIssuerNamespaceSelector(selector)),
+			namespace: "payments",
+			expected:  true,
+		},
+		"should allow a namespace matching the label selector": {
+			issuer:    gen.ClusterIssuer("test", gen.SetIssuerNamespaceSelector(selector)),
+			namespace: "dev",
+			expected:  true,
+		},
+		"should not allow a namespace that is not selected": {
+			issuer:    gen.ClusterIssuer("test", gen.SetIssuerNamespaceSelector(selector)),
+			namespace: "prod",
+			expected:  false,
+		},
+		"should not allow a namespace that does not exist": {
+			issuer:    gen.ClusterIssuer("test", gen.SetIssuerNamespaceSelector(selector)),
+			namespace: "missing",
+			expected:  false,
+		},
+		"should ignore the selector on an Issuer": {
+			issuer:    gen.Issuer("test", gen.SetIssuerNamespaceSelector(selector)),
+			namespace: "prod",
+			expected:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowed, err := NamespaceAllowed(namespaceLister, test.issuer, test.namespace)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if allowed != test.expected {
+				t.Errorf("expected allowed=%t but got %t", test.expected, allowed)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package issuer
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 )
@@ -35,8 +37,32 @@ type Interface interface {
 	// Sign attempts to issue a certificate as described by the CertificateRequest
 	// resource given
 	Sign(context.Context, *v1alpha1.CertificateRequest) (*IssueResponse, error)
+
+	// Revoke attempts to revoke the given certificate, issued for the
+	// certificate resource given, with the provided reason.
+	// Issuers that do not support revocation return ErrRevocationNotSupported.
+	Revoke(context.Context, *v1alpha1.Certificate, *x509.Certificate, v1alpha1.RevocationReason) error
 }
 
+// ErrRevocationNotSupported is returned by issuers that are not able to
+// revoke certificates they have issued.
+var ErrRevocationNotSupported = errors.New("issuer does not support certificate revocation")
+
+// RenewalInfoProvider is optionally implemented by issuers that are able to
+// suggest when a certificate they have issued should be renewed, for example
+// ahead of a mass revocation event.
+type RenewalInfoProvider interface {
+	// RenewalInfo returns the renewal window suggested by the issuer for the
+	// given certificate, issued for the certificate resource given.
+	// Issuers that are unable to provide renewal information for the
+	// certificate return ErrRenewalInfoNotSupported.
+	RenewalInfo(context.Context, *v1alpha1.Certificate, *x509.Certificate) (*v1alpha1.CertificateRenewalInfo, error)
+}
+
+// ErrRenewalInfoNotSupported is returned by issuers that are not able to
+// provide renewal information for a certificate.
+var ErrRenewalInfoNotSupported = errors.New("issuer does not support renewal information")
+
 type IssueResponse struct {
 	// Certificate is the certificate resource that should be stored in the
 	// target secret.
@@ -31,10 +31,11 @@ import (
 )
 
 func (c *SelfSigned) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.IssueResponse, error) {
-	// get a copy of the existing/currently issued Certificate's private key
-	signeePrivateKey, err := kube.SecretTLSKey(ctx, c.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	// get a copy of the existing/currently issued Certificate's private key,
+	// unless the Certificate's key rotation policy requires a new one
+	signeePrivateKey, err := kube.SecretTLSKeyForCertificate(ctx, c.secretsLister, crt)
 	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
-		// if one does not already exist, generate a new one
+		// if one does not already exist or must be rotated, generate a new one
 		signeePrivateKey, err = pki.GeneratePrivateKeyForCertificate(crt)
 		if err != nil {
 			c.Recorder.Eventf(crt, corev1.EventTypeWarning, "PrivateKeyError", "Error generating certificate private key: %v", err)
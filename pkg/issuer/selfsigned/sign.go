@@ -18,12 +18,94 @@ package selfsigned
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/pkg/util/kube"
+	"github.com/leki75/cert-manager/pkg/util/pki"
 )
 
+// Sign will self sign the CSR of the given CertificateRequest using the
+// private key stored in the Secret named by the CRPrivateKeyAnnotationKey
+// annotation.
 func (c *SelfSigned) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
-	return nil, errors.New("sign not implemented by SelfSigned issuer")
+	log := logf.FromContext(ctx, "sign")
+
+	secretName, ok := cr.Annotations[v1alpha1.CRPrivateKeyAnnotationKey]
+	if !ok || secretName == "" {
+		message := fmt.Sprintf("Annotation %q missing or reference empty", v1alpha1.CRPrivateKeyAnnotationKey)
+		c.Recorder.Event(cr, corev1.EventTypeWarning, "BadConfig", message)
+		c.failCertificateRequest(cr, message)
+		return nil, nil
+	}
+	log = logf.WithRelatedResourceName(log, secretName, cr.Namespace, "Secret")
+
+	privateKey, err := kube.SecretTLSKey(ctx, c.secretsLister, cr.Namespace, secretName)
+	if k8sErrors.IsNotFound(err) {
+		// the Secret may not have been created yet, so we retry
+		c.Recorder.Eventf(cr, corev1.EventTypeWarning, "MissingSecret", "Referenced secret %s/%s not found", cr.Namespace, secretName)
+		return nil, err
+	}
+	if errors.IsInvalidData(err) {
+		message := fmt.Sprintf("Failed to get key %q referenced in annotation %q: %v", secretName, v1alpha1.CRPrivateKeyAnnotationKey, err)
+		c.Recorder.Event(cr, corev1.EventTypeWarning, "ErrorParsingKey", message)
+		c.failCertificateRequest(cr, message)
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(err, "error getting private key for certificate request")
+		return nil, err
+	}
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(cr)
+	if err != nil {
+		message := fmt.Sprintf("Error generating certificate template: %v", err)
+		c.Recorder.Event(cr, corev1.EventTypeWarning, "ErrorSigning", message)
+		c.failCertificateRequest(cr, message)
+		return nil, nil
+	}
+
+	// the CSR may have been created using a private key generated for the
+	// next certificate, which is stored separately until it is issued
+	if matches, err := pki.PublicKeyMatchesCertificate(privateKey.Public(), template); err != nil || !matches {
+		if nextKey, err := kube.SecretNextTLSKey(ctx, c.secretsLister, cr.Namespace, secretName); err == nil {
+			privateKey = nextKey
+		}
+	}
+
+	// the CSR must have been created using the referenced private key, else
+	// the signed certificate will be unusable
+	matches, err := pki.PublicKeyMatchesCertificate(privateKey.Public(), template)
+	if err != nil || !matches {
+		message := fmt.Sprintf("Referenced private key %q does not match the public key of the CSR", secretName)
+		c.Recorder.Event(cr, corev1.EventTypeWarning, "ErrorKeyMatch", message)
+		c.failCertificateRequest(cr, message)
+		return nil, nil
+	}
+
+	// the certificate is signed by itself, so the template is also the issuer
+	certPem, _, err := pki.SignCertificate(template, template, template.PublicKey, privateKey)
+	if err != nil {
+		c.Recorder.Eventf(cr, corev1.EventTypeWarning, "ErrorSigning", "Error signing certificate: %v", err)
+		return nil, err
+	}
+
+	log.Info("self signed certificate issued")
+
+	return &issuer.IssueResponse{
+		Certificate: certPem,
+		CA:          certPem,
+	}, nil
+}
+
+func (c *SelfSigned) failCertificateRequest(cr *v1alpha1.CertificateRequest, message string) {
+	apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady,
+		v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, message)
 }
@@ -0,0 +1,169 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfsigned
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func generateCSR(t *testing.T, key crypto.Signer) []byte {
+	csrBytes, err := pki.EncodeCSR(&x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: "test"},
+		DNSNames:           []string{"example.com"},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}, key)
+	if err != nil {
+		t.Fatalf("failed to generate CSR: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})
+}
+
+func TestSign(t *testing.T) {
+	pk1, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk2, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-key",
+			Namespace: gen.DefaultTestNamespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: pki.EncodePKCS1PrivateKey(pk1),
+		},
+	}
+	badKeySecret := keySecret.DeepCopy()
+	badKeySecret.Data[corev1.TLSPrivateKeyKey] = []byte("bad-key")
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(generateCSR(t, pk1)),
+		gen.SetCertificateRequestAnnotations(map[string]string{
+			v1alpha1.CRPrivateKeyAnnotationKey: "test-key",
+		}),
+	)
+
+	tests := map[string]struct {
+		cr          *v1alpha1.CertificateRequest
+		kubeObjects []runtime.Object
+		expectErr   bool
+		expectFail  bool
+		expectCert  bool
+	}{
+		"sign a CertificateRequest using the referenced private key": {
+			cr:          baseCR,
+			kubeObjects: []runtime.Object{keySecret},
+			expectCert:  true,
+		},
+		"fail the request if the private key annotation is missing": {
+			cr: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestAnnotations(nil),
+			),
+			kubeObjects: []runtime.Object{keySecret},
+			expectFail:  true,
+		},
+		"return an error to retry if the private key secret does not exist": {
+			cr:        baseCR,
+			expectErr: true,
+		},
+		"fail the request if the private key cannot be decoded": {
+			cr:          baseCR,
+			kubeObjects: []runtime.Object{badKeySecret},
+			expectFail:  true,
+		},
+		"fail the request if the private key does not match the CSR": {
+			cr: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestCSR(generateCSR(t, pk2)),
+			),
+			kubeObjects: []runtime.Object{keySecret},
+			expectFail:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := &testpkg.Builder{
+				T:           t,
+				KubeObjects: test.kubeObjects,
+			}
+			b.Start()
+			defer b.Stop()
+
+			iss, err := NewSelfSigned(b.Context, gen.Issuer("selfsigned",
+				gen.SetIssuerSelfSigned(v1alpha1.SelfSignedIssuer{}),
+			))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.Sync()
+
+			cr := test.cr.DeepCopy()
+			resp, err := iss.Sign(context.Background(), cr)
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error=%t, got: %v", test.expectErr, err)
+			}
+
+			failed := apiutil.CertificateRequestHasCondition(cr, v1alpha1.CertificateRequestCondition{
+				Type:   v1alpha1.CertificateRequestConditionReady,
+				Status: v1alpha1.ConditionFalse,
+				Reason: v1alpha1.CertificateRequestReasonFailed,
+			})
+			if failed != test.expectFail {
+				t.Errorf("expected failed=%t, got conditions: %+v", test.expectFail, cr.Status.Conditions)
+			}
+
+			if !test.expectCert {
+				if resp != nil {
+					t.Errorf("expected no response, got: %+v", resp)
+				}
+				return
+			}
+			if resp == nil {
+				t.Fatalf("expected a response, got none")
+			}
+			cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+			if err != nil {
+				t.Fatalf("failed to decode signed certificate: %v", err)
+			}
+			if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+				t.Errorf("expected certificate to be self signed: %v", err)
+			}
+			if matches, err := pki.PublicKeyMatchesCertificate(pk1.Public(), cert); err != nil || !matches {
+				t.Errorf("expected certificate to be signed for the referenced private key")
+			}
+		})
+	}
+}
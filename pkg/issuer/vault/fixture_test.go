@@ -0,0 +1,205 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+)
+
+const (
+	fakeVaultToken = "fake-token"
+	fakeVaultPath  = "pki/sign/example-dot-com"
+)
+
+// fakeVault is a fake Vault server that implements the pki sign endpoint.
+// Certificates are signed by a self signed CA using the subject and SANs
+// of the submitted CSR.
+type fakeVault struct {
+	*httptest.Server
+
+	caKey  crypto.Signer
+	caCert *x509.Certificate
+	caPEM  []byte
+
+	// SignFunc can be set to override the response of the sign endpoint.
+	SignFunc func(w http.ResponseWriter, parameters map[string]string)
+
+	// parameters holds the parameters of the last request to the sign
+	// endpoint.
+	parameters map[string]string
+}
+
+func newFakeVault(t *testing.T) *fakeVault {
+	caKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatalf("failed to generate CA private key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-vault-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatalf("failed to create CA certificate: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		t.Fatalf("failed to parse CA certificate: %v", err)
+	}
+
+	f := &fakeVault{
+		caKey:  caKey,
+		caCert: caCert,
+		caPEM:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	return f
+}
+
+func (f *fakeVault) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != fakeVaultToken {
+		http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+		return
+	}
+	if r.Method != http.MethodPost || r.URL.Path != "/v1/"+fakeVaultPath {
+		http.Error(w, `{"errors":["unsupported path"]}`, http.StatusNotFound)
+		return
+	}
+
+	f.parameters = map[string]string{}
+	if err := json.NewDecoder(r.Body).Decode(&f.parameters); err != nil {
+		http.Error(w, `{"errors":["invalid request body"]}`, http.StatusBadRequest)
+		return
+	}
+
+	if f.SignFunc != nil {
+		f.SignFunc(w, f.parameters)
+		return
+	}
+
+	certPEM, err := f.sign(f.parameters)
+	if err != nil {
+		http.Error(w, `{"errors":["`+err.Error()+`"]}`, http.StatusBadRequest)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{
+			"certificate": string(certPEM),
+			"issuing_ca":  string(f.caPEM),
+			"ca_chain":    []string{string(f.caPEM)},
+		},
+	})
+}
+
+func (f *fakeVault) sign(parameters map[string]string) ([]byte, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes([]byte(parameters["csr"]))
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := time.ParseDuration(parameters["ttl"])
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		IPAddresses:  csr.IPAddresses,
+		URIs:         csr.URIs,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(ttl),
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, f.caCert, csr.PublicKey, f.caKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}), nil
+}
+
+type fixture struct {
+	Vault *Vault
+	*test.Builder
+
+	Issuer             v1alpha1.GenericIssuer
+	CertificateRequest *v1alpha1.CertificateRequest
+
+	CheckFn func(*testing.T, *fixture, ...interface{})
+	Err     bool
+
+	Ctx context.Context
+}
+
+func (s *fixture) Setup(t *testing.T) {
+	if s.Ctx == nil {
+		s.Ctx = context.Background()
+	}
+	if s.Builder == nil {
+		s.Builder = &test.Builder{}
+	}
+	if s.Builder.T == nil {
+		s.Builder.T = t
+	}
+	s.Builder.Start()
+	// TODO: replace this with a call to NewVault by somehow modifying it to
+	// allow injecting the fake listers.
+	s.Vault = &Vault{
+		Context:           s.Builder.Context,
+		issuer:            s.Issuer,
+		secretsLister:     s.Builder.Context.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		resourceNamespace: s.Builder.Context.IssuerOptions.ResourceNamespace(s.Issuer),
+	}
+	s.Builder.Sync()
+}
+
+func (s *fixture) Finish(t *testing.T, args ...interface{}) {
+	defer s.Builder.Stop()
+	if err := s.Builder.AllReactorsCalled(); err != nil {
+		t.Errorf("Not all expected reactors were called: %v", err)
+	}
+	if err := s.Builder.AllActionsExecuted(); err != nil {
+		t.Errorf(err.Error())
+	}
+
+	// resync listers before running checks
+	s.Builder.Sync()
+	// run custom checks
+	if s.CheckFn != nil {
+		s.CheckFn(t, s, args...)
+	}
+}
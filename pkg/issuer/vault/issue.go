@@ -52,10 +52,11 @@ const (
 )
 
 func (v *Vault) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.IssueResponse, error) {
-	// get a copy of the existing/currently issued Certificate's private key
-	signeePrivateKey, err := kube.SecretTLSKey(ctx, v.secretsLister, crt.Namespace, crt.Spec.SecretName)
+	// get a copy of the existing/currently issued Certificate's private key,
+	// unless the Certificate's key rotation policy requires a new one
+	signeePrivateKey, err := kube.SecretTLSKeyForCertificate(ctx, v.secretsLister, crt)
 	if k8sErrors.IsNotFound(err) || errors.IsInvalidData(err) {
-		// if one does not already exist, generate a new one
+		// if one does not already exist or must be rotated, generate a new one
 		signeePrivateKey, err = pki.GeneratePrivateKeyForCertificate(crt)
 		if err != nil {
 			v.Recorder.Eventf(crt, corev1.EventTypeWarning, "PrivateKeyError", "Error generating certificate private key: %v", err)
@@ -93,7 +94,9 @@ func (v *Vault) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.I
 		certDuration = crt.Spec.Duration.Duration
 	}
 
-	certPem, caPem, err := v.requestVaultCert(template.Subject.CommonName, certDuration, template.DNSNames, pki.IPAddressesToString(template.IPAddresses), pemRequestBuf.Bytes())
+	parameters := signParameters(template, pemRequestBuf.Bytes(), certDuration)
+
+	certPem, caPem, err := v.requestVaultCert(parameters)
 	if err != nil {
 		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "ErrorSigning", "Failed to request certificate: %v", err)
 		return nil, err
@@ -113,6 +116,25 @@ func (v *Vault) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.I
 	}, nil
 }
 
+// signParameters returns the parameters used to request that Vault signs
+// the given PEM encoded CSR.
+// Key usages are not included as the sign endpoint always uses the usages
+// configured on the Vault role.
+func signParameters(csr *x509.CertificateRequest, csrPEM []byte, duration time.Duration) map[string]string {
+	// Vault accepts email addresses alongside DNS names in alt_names
+	altNames := append(append([]string{}, csr.DNSNames...), csr.EmailAddresses...)
+
+	return map[string]string{
+		"common_name":          csr.Subject.CommonName,
+		"alt_names":            strings.Join(altNames, ","),
+		"ip_sans":              strings.Join(pki.IPAddressesToString(csr.IPAddresses), ","),
+		"uri_sans":             strings.Join(pki.URLsToString(csr.URIs), ","),
+		"ttl":                  duration.String(),
+		"csr":                  string(csrPEM),
+		"exclude_cn_from_sans": "true",
+	}
+}
+
 func (v *Vault) configureCertPool(cfg *vault.Config) error {
 	certs := v.issuer.GetSpec().Vault.CABundle
 	if len(certs) == 0 {
@@ -214,23 +236,14 @@ func (v *Vault) requestTokenWithAppRoleRef(client *vault.Client, appRole *v1alph
 	return token, nil
 }
 
-func (v *Vault) requestVaultCert(commonName string, certDuration time.Duration, altNames []string, ipSans []string, csr []byte) ([]byte, []byte, error) {
+func (v *Vault) requestVaultCert(parameters map[string]string) ([]byte, []byte, error) {
 
 	client, err := v.initVaultClient()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	klog.V(4).Infof("Vault certificate request for commonName %s altNames: %q ipSans: %q", commonName, altNames, ipSans)
-
-	parameters := map[string]string{
-		"common_name":          commonName,
-		"alt_names":            strings.Join(altNames, ","),
-		"ip_sans":              strings.Join(ipSans, ","),
-		"ttl":                  certDuration.String(),
-		"csr":                  string(csr),
-		"exclude_cn_from_sans": "true",
-	}
+	klog.V(4).Infof("Vault certificate request for commonName %s altNames: %q ipSans: %q uriSans: %q", parameters["common_name"], parameters["alt_names"], parameters["ip_sans"], parameters["uri_sans"])
 
 	url := path.Join("/v1", v.issuer.GetSpec().Vault.Path)
 
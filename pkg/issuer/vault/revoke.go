@@ -0,0 +1,75 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/vault/helper/certutil"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+// Revoke will request that Vault revokes the given certificate using the
+// revoke endpoint of the PKI secrets engine the issuer signs with.
+// Vault does not record a revocation reason, so the reason is only recorded
+// in the Certificate's status.
+func (v *Vault) Revoke(ctx context.Context, crt *v1alpha1.Certificate, cert *x509.Certificate, reason v1alpha1.RevocationReason) error {
+	log := logf.FromContext(ctx, "revoke")
+
+	client, err := v.initVaultClient()
+	if err != nil {
+		return err
+	}
+
+	url := path.Join("/v1", pkiMountPath(v.issuer.GetSpec().Vault.Path), "revoke")
+	request := client.NewRequest("POST", url)
+
+	err = request.SetJSONBody(map[string]string{
+		"serial_number": certutil.GetHexFormatted(cert.SerialNumber.Bytes(), ":"),
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding Vault parameters: %s", err.Error())
+	}
+
+	resp, err := client.RawRequest(request)
+	if err != nil {
+		return fmt.Errorf("error revoking certificate in Vault: %s", err.Error())
+	}
+	defer resp.Body.Close()
+
+	log.Info("certificate revoked")
+
+	return nil
+}
+
+// pkiMountPath returns the mount path of the PKI secrets engine from the
+// configured signing path, e.g. 'pki_int' for 'pki_int/sign/example-dot-com'.
+func pkiMountPath(p string) string {
+	p = strings.Trim(p, "/")
+	for _, op := range []string{"/sign/", "/issue/", "/sign-verbatim"} {
+		if i := strings.Index(p, op); i >= 0 {
+			return p[:i]
+		}
+	}
+	return path.Dir(p)
+}
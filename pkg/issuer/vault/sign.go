@@ -18,12 +18,49 @@ package vault
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util/pki"
 )
 
+// Sign will request that Vault signs the CSR of the given
+// CertificateRequest.
 func (v *Vault) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
-	return nil, errors.New("sign not implemented by vault issuer")
+	log := logf.FromContext(ctx, "sign")
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.CSRPEM)
+	if err != nil {
+		message := fmt.Sprintf("Failed to decode CSR in spec: %v", err)
+		v.Recorder.Event(cr, corev1.EventTypeWarning, "ErrorParsingCSR", message)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, message)
+		return nil, nil
+	}
+
+	certDuration := v1alpha1.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		certDuration = cr.Spec.Duration.Duration
+	}
+
+	parameters := signParameters(csr, cr.Spec.CSRPEM, certDuration)
+
+	certPem, caPem, err := v.requestVaultCert(parameters)
+	if err != nil {
+		log.Error(err, "failed to request certificate from vault")
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "ErrorSigning", "Failed to request certificate: %v", err)
+		return nil, err
+	}
+
+	log.Info("certificate issued")
+
+	return &issuer.IssueResponse{
+		Certificate: certPem,
+		CA:          caPem,
+	}, nil
 }
@@ -0,0 +1,249 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func generateCSR(t *testing.T, crt *v1alpha1.Certificate, key crypto.Signer) []byte {
+	template, err := pki.GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatalf("failed to generate CSR template: %v", err)
+	}
+	derBytes, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		t.Fatalf("failed to encode CSR: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes})
+}
+
+func checkNoCertificateSigned(t *testing.T, s *fixture, args ...interface{}) {
+	if resp := args[1].(*issuer.IssueResponse); resp != nil {
+		t.Errorf("unexpected response, exp='nil' got='%+v'", resp)
+	}
+}
+
+func TestSign(t *testing.T) {
+	key, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+	csrPEM := generateCSR(t, gen.Certificate("testcrt",
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateDNSNames("example.com", "www.example.com"),
+	), key)
+
+	vaultServer := newFakeVault(t)
+	defer vaultServer.Close()
+
+	vaultIssuer := gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(v1alpha1.VaultIssuer{
+			Server: vaultServer.URL,
+			Path:   fakeVaultPath,
+			Auth: v1alpha1.VaultAuth{
+				TokenSecretRef: v1alpha1.SecretKeySelector{
+					LocalObjectReference: v1alpha1.LocalObjectReference{Name: "vault-token"},
+				},
+			},
+		}),
+	)
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault-token",
+			Namespace: gen.DefaultTestNamespace,
+		},
+		Data: map[string][]byte{
+			"token": []byte(fakeVaultToken),
+		},
+	}
+	invalidTokenSecret := tokenSecret.DeepCopy()
+	invalidTokenSecret.Data["token"] = []byte("invalid-token")
+
+	tests := map[string]struct {
+		fixture
+		signFunc func(w http.ResponseWriter, parameters map[string]string)
+	}{
+		"sign a CertificateRequest": {
+			fixture: fixture{
+				Issuer: vaultIssuer,
+				CertificateRequest: gen.CertificateRequest("test-cr",
+					gen.SetCertificateRequestCSR(csrPEM),
+				),
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{tokenSecret},
+				},
+				CheckFn: func(t *testing.T, s *fixture, args ...interface{}) {
+					resp := args[1].(*issuer.IssueResponse)
+					if resp == nil {
+						t.Errorf("expected IssueResponse to be non-nil")
+						return
+					}
+					if len(resp.PrivateKey) > 0 {
+						t.Errorf("expected no private key to be returned when signing a CertificateRequest")
+					}
+					if !bytes.Equal(bytes.TrimSpace(resp.CA), bytes.TrimSpace(vaultServer.caPEM)) {
+						t.Errorf("expected CA to be the Vault CA, got: %s", resp.CA)
+					}
+					cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+					if err != nil {
+						t.Errorf("unable to decode x509 certificate: %v", err)
+						return
+					}
+					ok, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+					if err != nil || !ok {
+						t.Errorf("expected certificate to be issued for the public key of the CSR")
+					}
+
+					expected := map[string]string{
+						"common_name":          "example.com",
+						"alt_names":            "example.com,www.example.com",
+						"ttl":                  v1alpha1.DefaultCertificateDuration.String(),
+						"exclude_cn_from_sans": "true",
+					}
+					for k, v := range expected {
+						if vaultServer.parameters[k] != v {
+							t.Errorf("unexpected Vault parameter %q, exp=%q got=%q", k, v, vaultServer.parameters[k])
+						}
+					}
+					// the sign endpoint only uses the usages of the Vault role
+					for _, k := range []string{"key_usage", "ext_key_usage"} {
+						if _, ok := vaultServer.parameters[k]; ok {
+							t.Errorf("unexpected Vault parameter %q", k)
+						}
+					}
+				},
+				Err: false,
+			},
+		},
+		"request the duration of the CertificateRequest as the ttl": {
+			fixture: fixture{
+				Issuer: vaultIssuer,
+				CertificateRequest: gen.CertificateRequest("test-cr",
+					gen.SetCertificateRequestCSR(csrPEM),
+					gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour * 24}),
+				),
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{tokenSecret},
+				},
+				CheckFn: func(t *testing.T, s *fixture, args ...interface{}) {
+					if resp := args[1].(*issuer.IssueResponse); resp == nil {
+						t.Errorf("expected IssueResponse to be non-nil")
+					}
+					if ttl := vaultServer.parameters["ttl"]; ttl != "24h0m0s" {
+						t.Errorf("unexpected Vault ttl, exp=%q got=%q", "24h0m0s", ttl)
+					}
+				},
+				Err: false,
+			},
+		},
+		"mark a CertificateRequest with an invalid CSR as failed": {
+			fixture: fixture{
+				Issuer: vaultIssuer,
+				CertificateRequest: gen.CertificateRequest("test-cr",
+					gen.SetCertificateRequestCSR([]byte("bad-csr")),
+				),
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{tokenSecret},
+				},
+				CheckFn: func(t *testing.T, s *fixture, args ...interface{}) {
+					checkNoCertificateSigned(t, s, args...)
+					cr := args[0].(*v1alpha1.CertificateRequest)
+					if !apiutil.CertificateRequestHasCondition(cr, v1alpha1.CertificateRequestCondition{
+						Type:   v1alpha1.CertificateRequestConditionReady,
+						Status: v1alpha1.ConditionFalse,
+						Reason: v1alpha1.CertificateRequestReasonFailed,
+					}) {
+						t.Errorf("expected CertificateRequest to be marked as failed, got conditions: %v", cr.Status.Conditions)
+					}
+				},
+				Err: false,
+			},
+		},
+		"return an error if the Vault token secret does not exist": {
+			fixture: fixture{
+				Issuer: vaultIssuer,
+				CertificateRequest: gen.CertificateRequest("test-cr",
+					gen.SetCertificateRequestCSR(csrPEM),
+				),
+				CheckFn: checkNoCertificateSigned,
+				Err:     true,
+			},
+		},
+		"return an error if Vault rejects the token": {
+			fixture: fixture{
+				Issuer: vaultIssuer,
+				CertificateRequest: gen.CertificateRequest("test-cr",
+					gen.SetCertificateRequestCSR(csrPEM),
+				),
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{invalidTokenSecret},
+				},
+				CheckFn: checkNoCertificateSigned,
+				Err:     true,
+			},
+		},
+		"return an error if Vault fails to sign the CSR": {
+			fixture: fixture{
+				Issuer: vaultIssuer,
+				CertificateRequest: gen.CertificateRequest("test-cr",
+					gen.SetCertificateRequestCSR(csrPEM),
+				),
+				Builder: &testpkg.Builder{
+					KubeObjects: []runtime.Object{tokenSecret},
+				},
+				CheckFn: checkNoCertificateSigned,
+				Err:     true,
+			},
+			signFunc: func(w http.ResponseWriter, _ map[string]string) {
+				http.Error(w, `{"errors":["common name not allowed by this role"]}`, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultServer.SignFunc = test.signFunc
+			test.Setup(t)
+			crCopy := test.CertificateRequest.DeepCopy()
+			resp, err := test.Vault.Sign(test.Ctx, crCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, crCopy, resp, err)
+		})
+	}
+}
@@ -30,6 +30,7 @@ type fakeConnector struct {
 	RetrieveCertificateFunc   func(*certificate.Request) (*certificate.PEMCollection, error)
 	RequestCertificateFunc    func(*certificate.Request) (string, error)
 	RenewCertificateFunc      func(*certificate.RenewalRequest) (string, error)
+	RevokeCertificateFunc     func(*certificate.RevocationRequest) error
 }
 
 func (f fakeConnector) Default() *fakeConnector {
@@ -73,3 +74,10 @@ func (f *fakeConnector) RenewCertificate(req *certificate.RenewalRequest) (reque
 	}
 	return f.Connector.RenewCertificate(req)
 }
+
+func (f *fakeConnector) RevokeCertificate(req *certificate.RevocationRequest) (err error) {
+	if f.RevokeCertificateFunc != nil {
+		return f.RevokeCertificateFunc(req)
+	}
+	return f.Connector.RevokeCertificate(req)
+}
@@ -30,9 +30,10 @@ type fixture struct {
 	Venafi *Venafi
 	*test.Builder
 
-	Issuer      v1alpha1.GenericIssuer
-	Certificate *v1alpha1.Certificate
-	Client      connector
+	Issuer             v1alpha1.GenericIssuer
+	Certificate        *v1alpha1.Certificate
+	CertificateRequest *v1alpha1.CertificateRequest
+	Client             connector
 
 	PreFn   func(*testing.T, *fixture)
 	CheckFn func(*testing.T, *fixture, ...interface{})
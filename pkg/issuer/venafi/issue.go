@@ -18,19 +18,23 @@ package venafi
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
-	"fmt"
 	"strings"
 	"time"
 
 	"github.com/Venafi/vcert/pkg/certificate"
 	"github.com/Venafi/vcert/pkg/endpoint"
 	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
 	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/pkg/util/kube"
 	"github.com/leki75/cert-manager/pkg/util/pki"
 )
 
@@ -40,8 +44,9 @@ const (
 
 // Issue will attempt to issue a new certificate from the Venafi Issuer.
 // The control flow is as follows:
-// - Attempt to retrieve the existing private key
-// 		- If it does not exist, generate one
+// - Attempt to retrieve the existing private key if the key rotation policy
+//   is 'Never'
+// 		- If it does not exist, or the policy is not 'Never', generate one
 // - Generate a certificate template
 // - Read the zone configuration from the Venafi server
 // - Create a Venafi request based on the certificate template
@@ -58,21 +63,35 @@ func (v *Venafi) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.
 	dbg.Info("issue method called")
 	v.Recorder.Event(crt, corev1.EventTypeNormal, "Issuing", "Requesting new certificate...")
 
-	// Always generate a new private key, as some Venafi configurations mandate
-	// unique private keys per issuance.
-	dbg.Info("generating new private key for certificate")
-	signeeKey, err := pki.GeneratePrivateKeyForCertificate(crt)
-	if err != nil {
-		log.Error(err, "failed to generate private key for certificate")
-		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "PrivateKeyError", "Error generating certificate private key: %v", err)
-		// don't trigger a retry. An error from this function implies some
-		// invalid input parameters, and retrying without updating the
-		// resource will not help.
-		return nil, nil
+	// Unless the Certificate explicitly requests that its private key is
+	// reused, always generate a new private key, as some Venafi configurations
+	// mandate unique private keys per issuance.
+	var signeeKey crypto.Signer
+	var err error
+	if crt.Spec.KeyRotationPolicy == v1alpha1.KeyRotationPolicyNever {
+		dbg.Info("fetching existing private key for certificate")
+		signeeKey, err = kube.SecretTLSKeyForCertificate(ctx, v.secretsLister, crt)
+		if err != nil && !k8sErrors.IsNotFound(err) && !errors.IsInvalidData(err) {
+			log.Error(err, "error getting private key for certificate")
+			return nil, err
+		}
 	}
 
-	dbg.Info("generated new private key")
-	v.Recorder.Event(crt, corev1.EventTypeNormal, "GenerateKey", "Generated new private key")
+	if signeeKey == nil {
+		dbg.Info("generating new private key for certificate")
+		signeeKey, err = pki.GeneratePrivateKeyForCertificate(crt)
+		if err != nil {
+			log.Error(err, "failed to generate private key for certificate")
+			v.Recorder.Eventf(crt, corev1.EventTypeWarning, "PrivateKeyError", "Error generating certificate private key: %v", err)
+			// don't trigger a retry. An error from this function implies some
+			// invalid input parameters, and retrying without updating the
+			// resource will not help.
+			return nil, nil
+		}
+
+		dbg.Info("generated new private key")
+		v.Recorder.Event(crt, corev1.EventTypeNormal, "GenerateKey", "Generated new private key")
+	}
 
 	// extract the public component of the key
 	dbg.Info("extracting public key from private key")
@@ -148,12 +167,40 @@ func (v *Venafi) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.
 	// TODO: better set the timeout here. Right now, we'll block for this amount of time.
 	vreq.Timeout = time.Minute * 5
 
-	v.Recorder.Eventf(crt, corev1.EventTypeNormal, "Requesting", "Requesting certificate from Venafi server...")
+	chain, err := v.requestCertificate(logf.NewContext(ctx, log), crt, vreq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode the private key ready to be saved
+	dbg.Info("encoding generated private key")
+	pk, err := pki.EncodePrivateKey(signeeKey, crt.Spec.KeyEncoding)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &issuer.IssueResponse{
+		PrivateKey:  pk,
+		Certificate: chain,
+		// TODO: obtain CA certificate somehow
+		// CA: []byte{},
+	}, nil
+}
+
+// requestCertificate submits the given vcert Request to the Venafi server
+// and waits for the signed certificate to be available, returning the PEM
+// encoded certificate chain. Events are recorded against obj.
+func (v *Venafi) requestCertificate(ctx context.Context, obj runtime.Object, vreq *certificate.Request) ([]byte, error) {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	v.Recorder.Eventf(obj, corev1.EventTypeNormal, "Requesting", "Requesting certificate from Venafi server...")
 	// Actually send a request to the Venafi server for a certificate.
 	dbg.Info("submitting generated CSR to venafi")
 	requestID, err := v.client.RequestCertificate(vreq)
 	if err != nil {
-		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "Request", "Failed to request a certificate from Venafi: %v", err)
+		v.Recorder.Eventf(obj, corev1.EventTypeWarning, "Request", "Failed to request a certificate from Venafi: %v", err)
 		return nil, err
 	}
 
@@ -171,40 +218,25 @@ func (v *Venafi) Issue(ctx context.Context, crt *v1alpha1.Certificate) (*issuer.
 	// Check some known error types
 	if err, ok := err.(endpoint.ErrCertificatePending); ok {
 		log.Error(err, "venafi certificate still in a pending state, the request will be retried")
-		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi, still pending: %v", err)
-		return nil, fmt.Errorf("Venafi certificate still pending: %v", err)
+		v.Recorder.Eventf(obj, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi, still pending: %v", err)
+		return nil, errors.NewPending("Venafi certificate still pending: %v", err)
 	}
 	if err, ok := err.(endpoint.ErrRetrieveCertificateTimeout); ok {
 		log.Error(err, "timed out waiting for venafi certificate, the request will be retried")
-		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi, timed out: %v", err)
-		return nil, fmt.Errorf("Timed out waiting for certificate: %v", err)
+		v.Recorder.Eventf(obj, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi, timed out: %v", err)
+		return nil, errors.NewPending("Timed out waiting for certificate: %v", err)
 	}
 	if err != nil {
 		log.Error(err, "failed to obtain venafi certificate")
-		v.Recorder.Eventf(crt, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi: %v", err)
+		v.Recorder.Eventf(obj, corev1.EventTypeWarning, "Retrieve", "Failed to retrieve a certificate from Venafi: %v", err)
 		return nil, err
 	}
 	log.Info("successfully fetched signed certificate from venafi")
-	v.Recorder.Eventf(crt, corev1.EventTypeNormal, "Retrieve", "Retrieved certificate from Venafi server")
-
-	// Encode the private key ready to be saved
-	dbg.Info("encoding generated private key")
-	pk, err := pki.EncodePrivateKey(signeeKey, crt.Spec.KeyEncoding)
+	v.Recorder.Eventf(obj, corev1.EventTypeNormal, "Retrieve", "Retrieved certificate from Venafi server")
 
-	if err != nil {
-		return nil, err
-	}
-
-	dbg.Info("constructing certificate chain PEM and returning data")
-	// Construct the certificate chain and return the new keypair
+	dbg.Info("constructing certificate chain PEM")
 	cs := append([]string{pemCollection.Certificate}, pemCollection.Chain...)
-	chain := strings.Join(cs, "\n")
-	return &issuer.IssueResponse{
-		PrivateKey:  pk,
-		Certificate: []byte(chain),
-		// TODO: obtain CA certificate somehow
-		// CA: []byte{},
-	}, nil
+	return []byte(strings.Join(cs, "\n")), nil
 }
 
 func newVRequest(cert *x509.Certificate) *certificate.Request {
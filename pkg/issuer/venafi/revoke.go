@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/Venafi/vcert/pkg/certificate"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+)
+
+// revocationReasons maps cert-manager revocation reasons to the reasons
+// understood by vcert.
+var revocationReasons = map[cmapi.RevocationReason]string{
+	cmapi.RevocationReasonUnspecified:          "none",
+	cmapi.RevocationReasonKeyCompromise:        "key-compromise",
+	cmapi.RevocationReasonCACompromise:         "ca-compromise",
+	cmapi.RevocationReasonAffiliationChanged:   "affiliation-changed",
+	cmapi.RevocationReasonSuperseded:           "superseded",
+	cmapi.RevocationReasonCessationOfOperation: "cessation-of-operation",
+}
+
+// Revoke will request that Venafi revokes the given certificate, identified
+// by its SHA-1 thumbprint.
+func (v *Venafi) Revoke(ctx context.Context, crt *cmapi.Certificate, cert *x509.Certificate, reason cmapi.RevocationReason) error {
+	log := logf.FromContext(ctx, "revoke")
+
+	vReason, ok := revocationReasons[reason]
+	if !ok {
+		return fmt.Errorf("unsupported revocation reason %q", reason)
+	}
+
+	err := v.client.RevokeCertificate(&certificate.RevocationRequest{
+		Thumbprint: fmt.Sprintf("%X", sha1.Sum(cert.Raw)),
+		Reason:     vReason,
+		Comments:   fmt.Sprintf("Revoked by cert-manager for Certificate %s/%s", crt.Namespace, crt.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("error revoking certificate in Venafi: %v", err)
+	}
+
+	log.Info("certificate revoked")
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"crypto/sha1"
+	"crypto/x509"
+	"fmt"
+	"testing"
+
+	"github.com/Venafi/vcert/pkg/certificate"
+
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func TestRevoke(t *testing.T) {
+	cert := &x509.Certificate{Raw: []byte("certificate")}
+	thumbprint := fmt.Sprintf("%X", sha1.Sum(cert.Raw))
+
+	tests := map[string]struct {
+		reason         cmapi.RevocationReason
+		expectedReason string
+		revokeErr      error
+		err            bool
+	}{
+		"revoke a certificate by thumbprint": {
+			reason:         cmapi.RevocationReasonKeyCompromise,
+			expectedReason: "key-compromise",
+		},
+		"map an unspecified reason to none": {
+			reason:         cmapi.RevocationReasonUnspecified,
+			expectedReason: "none",
+		},
+		"reject an unknown revocation reason": {
+			reason: cmapi.RevocationReason("Unknown"),
+			err:    true,
+		},
+		"return an error if revocation fails": {
+			reason:         cmapi.RevocationReasonSuperseded,
+			expectedReason: "superseded",
+			revokeErr:      fmt.Errorf("not supported by endpoint"),
+			err:            true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var req *certificate.RevocationRequest
+			s := &fixture{
+				Certificate: gen.Certificate("testcrt"),
+				Client: fakeConnector{
+					RevokeCertificateFunc: func(r *certificate.RevocationRequest) error {
+						req = r
+						return test.revokeErr
+					},
+				}.Default(),
+			}
+			s.Setup(t)
+			defer s.Finish(t)
+
+			err := s.Venafi.Revoke(s.Ctx, s.Certificate, cert, test.reason)
+			if test.err != (err != nil) {
+				t.Errorf("expected error %t, got: %v", test.err, err)
+			}
+			if test.expectedReason == "" {
+				if req != nil {
+					t.Errorf("expected no revocation request to be made, got: %+v", req)
+				}
+				return
+			}
+			if req == nil {
+				t.Fatalf("expected a revocation request to be made")
+			}
+			if req.Thumbprint != thumbprint {
+				t.Errorf("expected thumbprint %q, got %q", thumbprint, req.Thumbprint)
+			}
+			if req.Reason != test.expectedReason {
+				t.Errorf("expected reason %q, got %q", test.expectedReason, req.Reason)
+			}
+		})
+	}
+}
@@ -18,12 +18,91 @@ package venafi
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"time"
 
+	"github.com/Venafi/vcert/pkg/certificate"
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	"github.com/leki75/cert-manager/pkg/issuer"
+	logf "github.com/leki75/cert-manager/pkg/logs"
+	"github.com/leki75/cert-manager/pkg/util/pki"
 )
 
+// Sign will submit the CSR of the given CertificateRequest to the Venafi
+// server. The control flow is as follows:
+// - Generate a certificate template from the CSR
+// - Read the zone configuration from the Venafi server
+// - Create a Venafi request based on the certificate template
+// - Set defaults on the request based on the zone
+// - Validate the request against the zone
+// - Submit the request along with the CSR
+// - Wait for the request to be fulfilled and the certificate to be available
 func (v *Venafi) Sign(ctx context.Context, cr *v1alpha1.CertificateRequest) (*issuer.IssueResponse, error) {
-	return nil, errors.New("sign not implemented by Venafi issuer")
+	log := logf.FromContext(ctx, "venafi")
+	log = logf.WithResource(log, cr)
+	dbg := log.V(logf.DebugLevel)
+
+	dbg.Info("sign method called")
+
+	// We build a x509.Certificate as the vcert library has support for converting
+	// this into its own internal Certificate Request type.
+	dbg.Info("constructing certificate request template to submit to venafi")
+	tmpl, err := pki.GenerateTemplateFromCertificateRequest(cr)
+	if err != nil {
+		message := fmt.Sprintf("Failed to generate certificate template from CSR: %v", err)
+		v.Recorder.Event(cr, corev1.EventTypeWarning, "ErrorParsingCSR", message)
+		apiutil.SetCertificateRequestCondition(cr, v1alpha1.CertificateRequestConditionReady,
+			v1alpha1.ConditionFalse, v1alpha1.CertificateRequestReasonFailed, message)
+		return nil, nil
+	}
+
+	// Retrieve a copy of the Venafi zone.
+	// This contains default values and policy control info that we can apply
+	// and check against locally.
+	dbg.Info("reading venafi zone configuration")
+	zoneCfg, err := v.client.ReadZoneConfiguration()
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "ReadZone", "Failed to read Venafi zone configuration: %v", err)
+		return nil, err
+	}
+
+	// Create a vcert Request structure
+	vreq := newVRequest(tmpl)
+
+	// Apply default values from the Venafi zone
+	dbg.Info("applying default venafi zone values to request")
+	zoneCfg.UpdateCertificateRequest(vreq)
+
+	dbg.Info("validating venafi certificate request")
+	err = zoneCfg.ValidateCertificateRequest(vreq)
+	if err != nil {
+		v.Recorder.Eventf(cr, corev1.EventTypeWarning, "Validate", "Failed to validate certificate against Venafi zone: %v", err)
+		return nil, err
+	}
+	dbg.Info("validated venafi certificate request")
+	v.Recorder.Eventf(cr, corev1.EventTypeNormal, "Validate", "Validated certificate request against Venafi zone policy")
+
+	// The CSR has been provided on the CertificateRequest, and as the spec
+	// of a CertificateRequest is immutable we know it has already been
+	// validated.
+	if err := vreq.SetCSR(cr.Spec.CSRPEM); err != nil {
+		return nil, err
+	}
+	vreq.CsrOrigin = certificate.UserProvidedCSR
+	// TODO: better set the timeout here. Right now, we'll block for this amount of time.
+	vreq.Timeout = time.Minute * 5
+
+	chain, err := v.requestCertificate(logf.NewContext(ctx, log), cr, vreq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issuer.IssueResponse{
+		Certificate: chain,
+		// TODO: obtain CA certificate somehow
+		// CA: []byte{},
+	}, nil
 }
@@ -0,0 +1,194 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"crypto"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/Venafi/vcert/pkg/certificate"
+	"github.com/Venafi/vcert/pkg/endpoint"
+
+	apiutil "github.com/leki75/cert-manager/pkg/api/util"
+	cmapi "github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	testpkg "github.com/leki75/cert-manager/pkg/controller/test"
+	"github.com/leki75/cert-manager/pkg/issuer"
+	"github.com/leki75/cert-manager/pkg/util"
+	"github.com/leki75/cert-manager/pkg/util/errors"
+	"github.com/leki75/cert-manager/pkg/util/pki"
+	"github.com/leki75/cert-manager/test/unit/gen"
+)
+
+func generateCSR(t *testing.T, crt *cmapi.Certificate, key crypto.Signer) []byte {
+	template, err := pki.GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatalf("failed to generate CSR template: %v", err)
+	}
+	derBytes, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		t.Fatalf("failed to encode CSR: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: derBytes})
+}
+
+func checkCertificateRequestSigned(key crypto.Signer) func(*testing.T, *fixture, ...interface{}) {
+	return func(t *testing.T, s *fixture, args ...interface{}) {
+		resp := args[1].(*issuer.IssueResponse)
+		if err, ok := args[2].(error); ok && err != nil {
+			t.Errorf("expected no error to be returned, but got: %v", err)
+			return
+		}
+		if resp == nil {
+			t.Errorf("expected IssueResponse to be non-nil")
+			return
+		}
+		if len(resp.PrivateKey) > 0 {
+			t.Errorf("expected no private key to be returned when signing a CertificateRequest")
+		}
+		cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+		if err != nil {
+			t.Errorf("unable to decode x509 certificate: %v", err)
+			return
+		}
+		ok, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+		if err != nil || !ok {
+			t.Errorf("expected certificate to be issued for the public key of the CSR")
+		}
+		if cert.Subject.CommonName != "example.com" {
+			t.Errorf("expected common name to be %q but it was %q", "example.com", cert.Subject.CommonName)
+		}
+		if !util.EqualUnsorted(cert.DNSNames, []string{"example.com"}) {
+			t.Errorf("expected dns names to be %q but it was %q", []string{"example.com"}, cert.DNSNames)
+		}
+	}
+}
+
+func checkNoCertificateSigned(t *testing.T, s *fixture, args ...interface{}) {
+	if resp := args[1].(*issuer.IssueResponse); resp != nil {
+		t.Errorf("unexpected response, exp='nil' got='%+v'", resp)
+	}
+}
+
+func TestSign(t *testing.T) {
+	key, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+	csrPEM := generateCSR(t, gen.Certificate("testcrt", gen.SetCertificateDNSNames("example.com")), key)
+
+	tests := map[string]fixture{
+		"sign a CertificateRequest": {
+			CertificateRequest: gen.CertificateRequest("testcr",
+				gen.SetCertificateRequestCSR(csrPEM),
+			),
+			CheckFn: checkCertificateRequestSigned(key),
+			Err:     false,
+		},
+		"mark a CertificateRequest with an invalid CSR as failed": {
+			CertificateRequest: gen.CertificateRequest("testcr",
+				gen.SetCertificateRequestCSR([]byte("bad-csr")),
+			),
+			CheckFn: func(t *testing.T, s *fixture, args ...interface{}) {
+				checkNoCertificateSigned(t, s, args...)
+				cr := args[0].(*cmapi.CertificateRequest)
+				if !apiutil.CertificateRequestHasCondition(cr, cmapi.CertificateRequestCondition{
+					Type:   cmapi.CertificateRequestConditionReady,
+					Status: cmapi.ConditionFalse,
+					Reason: cmapi.CertificateRequestReasonFailed,
+				}) {
+					t.Errorf("expected CertificateRequest to be marked as failed, got conditions: %v", cr.Status.Conditions)
+				}
+			},
+			Err: false,
+		},
+		"return an error if the venafi zone cannot be read": {
+			CertificateRequest: gen.CertificateRequest("testcr",
+				gen.SetCertificateRequestCSR(csrPEM),
+			),
+			Client: fakeConnector{
+				ReadZoneConfigurationFunc: func() (*endpoint.ZoneConfiguration, error) {
+					return nil, fmt.Errorf("zone not found")
+				},
+			}.Default(),
+			CheckFn: checkNoCertificateSigned,
+			Err:     true,
+		},
+		"return an error if the CSR does not satisfy the venafi zone policy": {
+			CertificateRequest: gen.CertificateRequest("testcr",
+				gen.SetCertificateRequestCSR(csrPEM),
+			),
+			Client: fakeConnector{
+				ReadZoneConfigurationFunc: func() (*endpoint.ZoneConfiguration, error) {
+					zone := endpoint.NewZoneConfiguration()
+					zone.SubjectCNRegexes = []string{`.*\.venafi\.example$`}
+					return zone, nil
+				},
+			}.Default(),
+			CheckFn: checkNoCertificateSigned,
+			Err:     true,
+		},
+		"return an error if the certificate cannot be requested": {
+			CertificateRequest: gen.CertificateRequest("testcr",
+				gen.SetCertificateRequestCSR(csrPEM),
+			),
+			Client: fakeConnector{
+				RequestCertificateFunc: func(*certificate.Request) (string, error) {
+					return "", fmt.Errorf("request rejected")
+				},
+			}.Default(),
+			CheckFn: checkNoCertificateSigned,
+			Err:     true,
+		},
+		"return a pending error if the certificate has not yet been issued": {
+			CertificateRequest: gen.CertificateRequest("testcr",
+				gen.SetCertificateRequestCSR(csrPEM),
+			),
+			Client: fakeConnector{
+				RetrieveCertificateFunc: func(*certificate.Request) (*certificate.PEMCollection, error) {
+					return nil, endpoint.ErrCertificatePending{CertificateID: "test"}
+				},
+			}.Default(),
+			CheckFn: func(t *testing.T, s *fixture, args ...interface{}) {
+				checkNoCertificateSigned(t, s, args...)
+				if err, _ := args[2].(error); !errors.IsPending(err) {
+					t.Errorf("expected a pending error, got: %v", err)
+				}
+			},
+			Err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.Builder == nil {
+				test.Builder = &testpkg.Builder{}
+			}
+			test.Setup(t)
+			crCopy := test.CertificateRequest.DeepCopy()
+			resp, err := test.Venafi.Sign(test.Ctx, crCopy)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, crCopy, resp, err)
+		})
+	}
+}
@@ -30,3 +30,18 @@ func IsInvalidData(err error) bool {
 	}
 	return true
 }
+
+type pendingError struct{ error }
+
+// NewPending returns an error indicating that a request is still being
+// processed, and so should be retried without being treated as a failure.
+func NewPending(str string, obj ...interface{}) error {
+	return &pendingError{error: fmt.Errorf(str, obj...)}
+}
+
+func IsPending(err error) bool {
+	if _, ok := err.(*pendingError); !ok {
+		return false
+	}
+	return true
+}
@@ -20,10 +20,13 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	"strings"
 
 	api "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
 	logf "github.com/leki75/cert-manager/pkg/logs"
 	"github.com/leki75/cert-manager/pkg/util/errors"
 	"github.com/leki75/cert-manager/pkg/util/pki"
@@ -66,6 +69,86 @@ func SecretTLSKey(ctx context.Context, secretLister corelisters.SecretLister, na
 	return SecretTLSKeyRef(ctx, secretLister, namespace, name, api.TLSPrivateKeyKey)
 }
 
+// SecretNextTLSKey will decode the private key stored in the
+// NextPrivateKeySecretKey entry of the secret with 'name' in 'namespace'.
+// This is a private key that has been generated for a certificate that has
+// not yet been issued. A NotFound error is returned if there is no such key.
+func SecretNextTLSKey(ctx context.Context, secretLister corelisters.SecretLister, namespace, name string) (crypto.Signer, error) {
+	secret, err := secretLister.Secrets(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes, ok := secret.Data[v1alpha1.NextPrivateKeySecretKey]
+	if !ok || len(keyBytes) == 0 {
+		return nil, k8sErrors.NewNotFound(api.Resource("secrets"), name)
+	}
+
+	key, err := pki.DecodePrivateKeyBytes(keyBytes)
+	if err != nil {
+		return nil, errors.NewInvalidData(err.Error())
+	}
+
+	return key, nil
+}
+
+// SecretTLSKeyForCertificate will return the private key stored in the target
+// Secret of the given Certificate if it may be reused to issue the
+// Certificate, according to the Certificate's KeyRotationPolicy.
+// If a private key has been generated for a certificate that has not yet
+// been issued, and it matches the key algorithm and size requested on the
+// Certificate, it is always returned.
+// If the policy is 'Always' and the stored private key has already been used
+// for a certificate other than a temporary certificate, an InvalidData error
+// is returned so that the caller generates a new private key, in the same way
+// as if the stored private key could not be decoded.
+// An InvalidData error is also returned if the stored private key does not
+// match the key algorithm and size requested on the Certificate.
+func SecretTLSKeyForCertificate(ctx context.Context, secretLister corelisters.SecretLister, crt *v1alpha1.Certificate) (crypto.Signer, error) {
+	nextKey, err := SecretNextTLSKey(ctx, secretLister, crt.Namespace, crt.Spec.SecretName)
+	if err == nil && len(pki.PrivateKeyMatchesSpec(nextKey, crt.Spec)) == 0 {
+		return nextKey, nil
+	}
+
+	key, err := SecretTLSKey(ctx, secretLister, crt.Namespace, crt.Spec.SecretName)
+	if err != nil {
+		return key, err
+	}
+
+	// a private key that no longer matches the requested key algorithm or
+	// size can never be reused, regardless of the KeyRotationPolicy
+	if errs := pki.PrivateKeyMatchesSpec(key, crt.Spec); len(errs) > 0 {
+		return nil, errors.NewInvalidData("private key in secret '%s/%s' does not match spec: %s", crt.Namespace, crt.Spec.SecretName, strings.Join(errs, ", "))
+	}
+
+	if crt.Spec.KeyRotationPolicy != v1alpha1.KeyRotationPolicyAlways {
+		return key, nil
+	}
+
+	log := logf.FromContext(ctx)
+	log = logf.WithRelatedResourceName(log, crt.Spec.SecretName, crt.Namespace, "Secret")
+
+	secret, err := secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	// if there is no valid certificate stored alongside the private key, the
+	// key has not yet been used and so can be reused.
+	certs, err := pki.DecodeX509CertificateChainBytes(secret.Data[api.TLSCertKey])
+	if err != nil || pki.IsTemporaryCertificate(certs[0]) {
+		return key, nil
+	}
+
+	matches, err := pki.PublicKeyMatchesCertificate(key.Public(), certs[0])
+	if err != nil || !matches {
+		return key, nil
+	}
+
+	log.V(logf.DebugLevel).Info("existing private key has already been used to issue a certificate and will be rotated")
+	return nil, errors.NewInvalidData("private key in secret '%s/%s' has already been issued and must be rotated", crt.Namespace, crt.Spec.SecretName)
+}
+
 func SecretTLSCertChain(ctx context.Context, secretLister corelisters.SecretLister, namespace, name string) ([]*x509.Certificate, error) {
 	log := logf.FromContext(ctx)
 	log = logf.WithRelatedResourceName(log, name, namespace, "Secret")
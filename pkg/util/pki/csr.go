@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"net/url"
 	"time"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -80,6 +81,50 @@ func IPAddressesToString(ipAddresses []net.IP) []string {
 	return ipNames
 }
 
+// URIsForCertificate returns the URI Subject Alternative Names that should be
+// used for the given Certificate resource.
+func URIsForCertificate(crt *v1alpha1.Certificate) ([]*url.URL, error) {
+	uris, err := ParseURIs(crt.Spec.URISANs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URI SANs: %s", err)
+	}
+
+	return uris, nil
+}
+
+// ParseURIs parses each of the given strings as an absolute URI.
+func ParseURIs(uris []string) ([]*url.URL, error) {
+	var urls []*url.URL
+	for _, uriName := range uris {
+		uri, err := url.Parse(uriName)
+		if err != nil {
+			return nil, err
+		}
+		if !uri.IsAbs() {
+			return nil, fmt.Errorf("URI %q is not absolute", uriName)
+		}
+		urls = append(urls, uri)
+	}
+	return urls, nil
+}
+
+func URLsToString(uris []*url.URL) []string {
+	var uriStrs []string
+	for _, uri := range uris {
+		if uri == nil {
+			continue
+		}
+		uriStrs = append(uriStrs, uri.String())
+	}
+	return uriStrs
+}
+
+// EmailAddressesForCertificate returns the email address Subject Alternative
+// Names that should be used for the given Certificate resource.
+func EmailAddressesForCertificate(crt *v1alpha1.Certificate) []string {
+	return crt.Spec.EmailSANs
+}
+
 func removeDuplicates(in []string) []string {
 	var found []string
 Outer:
@@ -107,8 +152,46 @@ func OrganizationForCertificate(crt *v1alpha1.Certificate) []string {
 	return crt.Spec.Organization
 }
 
+// SubjectForCertificate will return the X.509 distinguished name to set for
+// the Certificate resource, built from its CommonName, Organization and
+// Subject fields.
+func SubjectForCertificate(crt *v1alpha1.Certificate) pkix.Name {
+	name := pkix.Name{
+		CommonName:   CommonNameForCertificate(crt),
+		Organization: OrganizationForCertificate(crt),
+	}
+
+	if subject := crt.Spec.Subject; subject != nil {
+		name.Country = subject.Countries
+		name.OrganizationalUnit = subject.OrganizationalUnits
+		name.Locality = subject.Localities
+		name.Province = subject.Provinces
+		name.StreetAddress = subject.StreetAddresses
+		name.PostalCode = subject.PostalCodes
+		name.SerialNumber = subject.SerialNumber
+	}
+
+	return name
+}
+
 var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
 
+// TemporaryCertificateSerialNumber is a fixed serial number set on the
+// temporary certificates generated whilst a Certificate is being issued.
+// It is used to identify temporarily generated certificates, so that friendly
+// status messages can be displayed to users and so that a private key that has
+// only ever been used for a temporary certificate is not rotated.
+const TemporaryCertificateSerialNumber = 0x1234567890
+
+// IsTemporaryCertificate returns true if the given certificate is a temporary
+// certificate, as identified by TemporaryCertificateSerialNumber.
+func IsTemporaryCertificate(cert *x509.Certificate) bool {
+	if cert == nil {
+		return false
+	}
+	return cert.SerialNumber.Int64() == TemporaryCertificateSerialNumber
+}
+
 // GenerateCSR will generate a new *x509.CertificateRequest template to be used
 // by issuers that utilise CSRs to obtain Certificates.
 // The CSR will not be signed, and should be passed to either EncodeCSR or
@@ -117,10 +200,16 @@ func GenerateCSR(issuer v1alpha1.GenericIssuer, crt *v1alpha1.Certificate) (*x50
 	commonName := CommonNameForCertificate(crt)
 	dnsNames := DNSNamesForCertificate(crt)
 	iPAddresses := IPAddressesForCertificate(crt)
-	organization := OrganizationForCertificate(crt)
+	emailAddresses := EmailAddressesForCertificate(crt)
+	subject := SubjectForCertificate(crt)
+
+	uris, err := URIsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
 
-	if len(commonName) == 0 && len(dnsNames) == 0 {
-		return nil, fmt.Errorf("no domains specified on certificate")
+	if len(commonName) == 0 && len(dnsNames) == 0 && len(iPAddresses) == 0 && len(uris) == 0 && len(emailAddresses) == 0 {
+		return nil, fmt.Errorf("no common name or subject alt names specified on certificate")
 	}
 
 	pubKeyAlgo, sigAlgo, err := SignatureAlgorithm(crt)
@@ -128,18 +217,26 @@ func GenerateCSR(issuer v1alpha1.GenericIssuer, crt *v1alpha1.Certificate) (*x50
 		return nil, err
 	}
 
+	ku, eku, err := KeyUsagesForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions, err := BuildKeyUsageExtensions(ku, eku)
+	if err != nil {
+		return nil, err
+	}
+
 	return &x509.CertificateRequest{
 		Version:            3,
 		SignatureAlgorithm: sigAlgo,
 		PublicKeyAlgorithm: pubKeyAlgo,
-		Subject: pkix.Name{
-			Organization: organization,
-			CommonName:   commonName,
-		},
-		DNSNames:    dnsNames,
-		IPAddresses: iPAddresses,
-		// TODO: work out how best to handle extensions/key usages here
-		ExtraExtensions: []pkix.Extension{},
+		Subject:            subject,
+		DNSNames:           dnsNames,
+		IPAddresses:        iPAddresses,
+		URIs:               uris,
+		EmailAddresses:     emailAddresses,
+		ExtraExtensions:    extensions,
 	}, nil
 }
 
@@ -151,10 +248,16 @@ func GenerateTemplate(crt *v1alpha1.Certificate) (*x509.Certificate, error) {
 	commonName := CommonNameForCertificate(crt)
 	dnsNames := DNSNamesForCertificate(crt)
 	ipAddresses := IPAddressesForCertificate(crt)
-	organization := OrganizationForCertificate(crt)
+	emailAddresses := EmailAddressesForCertificate(crt)
+	subject := SubjectForCertificate(crt)
 
-	if len(commonName) == 0 && len(dnsNames) == 0 {
-		return nil, fmt.Errorf("no domains specified on certificate")
+	uris, err := URIsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(commonName) == 0 && len(dnsNames) == 0 && len(ipAddresses) == 0 && len(uris) == 0 && len(emailAddresses) == 0 {
+		return nil, fmt.Errorf("no common name or subject alt names specified on certificate")
 	}
 
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
@@ -172,34 +275,30 @@ func GenerateTemplate(crt *v1alpha1.Certificate) (*x509.Certificate, error) {
 		return nil, err
 	}
 
+	ku, eku, err := KeyUsagesForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
 	return &x509.Certificate{
 		Version:               3,
 		BasicConstraintsValid: true,
 		SerialNumber:          serialNumber,
 		PublicKeyAlgorithm:    pubKeyAlgo,
 		IsCA:                  crt.Spec.IsCA,
-		Subject: pkix.Name{
-			Organization: organization,
-			CommonName:   commonName,
-		},
-		NotBefore: time.Now(),
-		NotAfter:  time.Now().Add(certDuration),
+		Subject:               subject,
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(certDuration),
 		// see http://golang.org/pkg/crypto/x509/#KeyUsage
-		KeyUsage:    keyUsage(crt.Spec.IsCA),
-		DNSNames:    dnsNames,
-		IPAddresses: ipAddresses,
+		KeyUsage:       ku,
+		ExtKeyUsage:    eku,
+		DNSNames:       dnsNames,
+		IPAddresses:    ipAddresses,
+		URIs:           uris,
+		EmailAddresses: emailAddresses,
 	}, nil
 }
 
-func keyUsage(isCA bool) x509.KeyUsage {
-	keyUsages := x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
-	if isCA {
-		keyUsages |= x509.KeyUsageCertSign
-	}
-
-	return keyUsages
-}
-
 // GenerateTemplate will create a x509.Certificate for the given
 // CertificateRequest resource
 func GenerateTemplateFromCertificateRequest(cr *v1alpha1.CertificateRequest) (*x509.Certificate, error) {
@@ -228,6 +327,11 @@ func GenerateTemplateFromCertificateRequest(cr *v1alpha1.CertificateRequest) (*x
 		certDuration = cr.Spec.Duration.Duration
 	}
 
+	ku, eku, err := KeyUsagesForCertificateRequest(cr, csr)
+	if err != nil {
+		return nil, err
+	}
+
 	return &x509.Certificate{
 		Version:               csr.Version,
 		BasicConstraintsValid: true,
@@ -239,18 +343,44 @@ func GenerateTemplateFromCertificateRequest(cr *v1alpha1.CertificateRequest) (*x
 		NotBefore:             time.Now(),
 		NotAfter:              time.Now().Add(certDuration),
 		// see http://golang.org/pkg/crypto/x509/#KeyUsage
-		KeyUsage:    keyUsage(cr.Spec.IsCA),
-		DNSNames:    csr.DNSNames,
-		IPAddresses: csr.IPAddresses,
-		URIs:        csr.URIs,
-		// TODO: we should expose ExtKeyUsage via the API and not set x509.ExtKeyUsageClientAuth
-		// by default. This is a known change in behaviour between the Certificate and CertificateRequest
-		// controller and should be rectified before the CertificateRequest feature exits
-		// alpha.
-		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:       ku,
+		ExtKeyUsage:    eku,
+		DNSNames:       csr.DNSNames,
+		IPAddresses:    csr.IPAddresses,
+		URIs:           csr.URIs,
+		EmailAddresses: csr.EmailAddresses,
 	}, nil
 }
 
+// KeyUsagesForCertificateRequest returns the x509.KeyUsage and list of
+// x509.ExtKeyUsage to be set on the certificate signed for the given
+// CertificateRequest resource.
+// Usages set on the CertificateRequest spec take precedence, followed by the
+// usages requested in the extensions of the CSR itself. If neither are set,
+// v1alpha1.DefaultKeyUsages will be used along with the 'server auth' and
+// 'client auth' extended key usages.
+func KeyUsagesForCertificateRequest(cr *v1alpha1.CertificateRequest, csr *x509.CertificateRequest) (x509.KeyUsage, []x509.ExtKeyUsage, error) {
+	if len(cr.Spec.Usages) > 0 {
+		return BuildKeyUsages(cr.Spec.Usages, cr.Spec.IsCA)
+	}
+
+	ku, eku, found, err := KeyUsagesForCSR(csr)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !found {
+		// CertificateRequests have always been signed with both server and
+		// client auth by default, unlike Certificates.
+		return BuildKeyUsages(append(v1alpha1.DefaultKeyUsages(), v1alpha1.UsageServerAuth, v1alpha1.UsageClientAuth), cr.Spec.IsCA)
+	}
+
+	if cr.Spec.IsCA {
+		ku |= x509.KeyUsageCertSign
+	}
+
+	return ku, eku, nil
+}
+
 // SignCertificate returns a signed x509.Certificate object for the given
 // *v1alpha1.Certificate crt.
 // publicKey is the public key of the signee, and signerKey is the private
@@ -392,8 +522,11 @@ func SignatureAlgorithm(crt *v1alpha1.Certificate) (x509.PublicKeyAlgorithm, x50
 		default:
 			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported ecdsa keysize specified: %d", crt.Spec.KeySize)
 		}
+	case v1alpha1.Ed25519KeyAlgorithm:
+		pubKeyAlgo = x509.Ed25519
+		sigAlgo = x509.PureEd25519
 	default:
-		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa' or 'rsa", crt.Spec.KeyAlgorithm)
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa', 'ed25519' or 'rsa'", crt.Spec.KeyAlgorithm)
 	}
 	return pubKeyAlgo, sigAlgo, nil
 }
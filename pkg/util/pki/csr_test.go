@@ -18,6 +18,8 @@ package pki
 
 import (
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"reflect"
 	"testing"
 
 	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
@@ -139,6 +141,137 @@ func TestDNSNamesForCertificate(t *testing.T) {
 	}
 }
 
+func TestURIsForCertificate(t *testing.T) {
+	type testT struct {
+		name       string
+		crtURISANs []string
+		expectURIs []string
+		expectErr  bool
+	}
+	tests := []testT{
+		{
+			name:       "certificate with no uriSANs set",
+			expectURIs: nil,
+		},
+		{
+			name:       "certificate with spiffe uriSAN set",
+			crtURISANs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+			expectURIs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+		},
+		{
+			name:       "certificate with multiple uriSANs set",
+			crtURISANs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo", "https://example.com/foo"},
+			expectURIs: []string{"spiffe://cluster.local/ns/sandbox/sa/foo", "https://example.com/foo"},
+		},
+		{
+			name:       "certificate with relative uriSAN set",
+			crtURISANs: []string{"/ns/sandbox/sa/foo"},
+			expectErr:  true,
+		},
+	}
+	testFn := func(test testT) func(*testing.T) {
+		return func(t *testing.T) {
+			crt := &v1alpha1.Certificate{
+				Spec: v1alpha1.CertificateSpec{
+					URISANs: test.crtURISANs,
+				},
+			}
+			actualURIs, err := URIsForCertificate(crt)
+			if test.expectErr != (err != nil) {
+				t.Errorf("expected error=%t but got: %v", test.expectErr, err)
+				return
+			}
+			if !util.EqualUnsorted(URLsToString(actualURIs), test.expectURIs) {
+				t.Errorf("expected %q but got %q", test.expectURIs, URLsToString(actualURIs))
+			}
+		}
+	}
+	for _, test := range tests {
+		t.Run(test.name, testFn(test))
+	}
+}
+
+func TestGenerateTemplateWithOnlySANs(t *testing.T) {
+	crt := &v1alpha1.Certificate{
+		Spec: v1alpha1.CertificateSpec{
+			URISANs:   []string{"spiffe://cluster.local/ns/sandbox/sa/foo"},
+			EmailSANs: []string{"alice@example.com"},
+		},
+	}
+
+	template, err := GenerateTemplate(crt)
+	if err != nil {
+		t.Fatalf("unexpected error generating template: %v", err)
+	}
+	if !util.EqualUnsorted(URLsToString(template.URIs), crt.Spec.URISANs) {
+		t.Errorf("expected URIs %q but got %q", crt.Spec.URISANs, URLsToString(template.URIs))
+	}
+	if !util.EqualUnsorted(template.EmailAddresses, crt.Spec.EmailSANs) {
+		t.Errorf("expected email addresses %q but got %q", crt.Spec.EmailSANs, template.EmailAddresses)
+	}
+
+	csr, err := GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatalf("unexpected error generating csr: %v", err)
+	}
+	if !util.EqualUnsorted(URLsToString(csr.URIs), crt.Spec.URISANs) {
+		t.Errorf("expected URIs %q but got %q", crt.Spec.URISANs, URLsToString(csr.URIs))
+	}
+	if !util.EqualUnsorted(csr.EmailAddresses, crt.Spec.EmailSANs) {
+		t.Errorf("expected email addresses %q but got %q", crt.Spec.EmailSANs, csr.EmailAddresses)
+	}
+
+	if _, err := GenerateTemplate(&v1alpha1.Certificate{}); err == nil {
+		t.Errorf("expected error generating template with no names set")
+	}
+}
+
+func TestGenerateTemplateWithSubject(t *testing.T) {
+	crt := &v1alpha1.Certificate{
+		Spec: v1alpha1.CertificateSpec{
+			CommonName:   "example.com",
+			Organization: []string{"Example Org"},
+			Subject: &v1alpha1.X509Subject{
+				Countries:           []string{"GB"},
+				OrganizationalUnits: []string{"Engineering"},
+				Localities:          []string{"London"},
+				Provinces:           []string{"Greater London"},
+				StreetAddresses:     []string{"1 Example Street"},
+				PostalCodes:         []string{"EC1A 1AA"},
+				SerialNumber:        "12345",
+			},
+		},
+	}
+
+	expected := pkix.Name{
+		CommonName:         "example.com",
+		Organization:       []string{"Example Org"},
+		Country:            []string{"GB"},
+		OrganizationalUnit: []string{"Engineering"},
+		Locality:           []string{"London"},
+		Province:           []string{"Greater London"},
+		StreetAddress:      []string{"1 Example Street"},
+		PostalCode:         []string{"EC1A 1AA"},
+		SerialNumber:       "12345",
+	}
+
+	template, err := GenerateTemplate(crt)
+	if err != nil {
+		t.Fatalf("unexpected error generating template: %v", err)
+	}
+	if !reflect.DeepEqual(template.Subject, expected) {
+		t.Errorf("expected template subject %+v but got %+v", expected, template.Subject)
+	}
+
+	csr, err := GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatalf("unexpected error generating csr: %v", err)
+	}
+	if !reflect.DeepEqual(csr.Subject, expected) {
+		t.Errorf("expected csr subject %+v but got %+v", expected, csr.Subject)
+	}
+}
+
 func TestSignatureAlgorithmForCertificate(t *testing.T) {
 	type testT struct {
 		name            string
@@ -222,6 +355,12 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 			keySize:   100,
 			expectErr: true,
 		},
+		{
+			name:            "certificate with KeyAlgorithm ed25519",
+			keyAlgo:         v1alpha1.Ed25519KeyAlgorithm,
+			expectedSigAlgo: x509.PureEd25519,
+			expectedKeyType: x509.Ed25519,
+		},
 		{
 			name:      "certificate with KeyAlgorithm set to unknown key algo",
 			keyAlgo:   v1alpha1.KeyAlgorithm("blah"),
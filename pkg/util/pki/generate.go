@@ -17,8 +17,10 @@ limitations under the License.
 package pki
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -49,7 +51,7 @@ const (
 // GeneratePrivateKeyForCertificate will generate a private key suitable for
 // the provided cert-manager Certificate resource, taking into account the
 // parameters on the provided resource.
-// The returned key will either be RSA or ECDSA.
+// The returned key will either be RSA, ECDSA or Ed25519.
 func GeneratePrivateKeyForCertificate(crt *v1alpha1.Certificate) (crypto.Signer, error) {
 	switch crt.Spec.KeyAlgorithm {
 	case v1alpha1.KeyAlgorithm(""), v1alpha1.RSAKeyAlgorithm:
@@ -68,6 +70,8 @@ func GeneratePrivateKeyForCertificate(crt *v1alpha1.Certificate) (crypto.Signer,
 		}
 
 		return GenerateECPrivateKey(keySize)
+	case v1alpha1.Ed25519KeyAlgorithm:
+		return GenerateEd25519PrivateKey()
 	default:
 		return nil, fmt.Errorf("unsupported private key algorithm specified: %s", crt.Spec.KeyAlgorithm)
 	}
@@ -107,9 +111,17 @@ func GenerateECPrivateKey(keySize int) (*ecdsa.PrivateKey, error) {
 	return ecdsa.GenerateKey(ecCurve, rand.Reader)
 }
 
+// GenerateEd25519PrivateKey will generate an Ed25519 private key.
+func GenerateEd25519PrivateKey() (ed25519.PrivateKey, error) {
+	_, pk, err := ed25519.GenerateKey(rand.Reader)
+	return pk, err
+}
+
 // EncodePrivateKey will encode a given crypto.PrivateKey by first inspecting
 // the type of key encoding and then inspecting the type of key provided.
-// It only supports encoding RSA or ECDSA keys.
+// It only supports encoding RSA, ECDSA or Ed25519 keys.
+// As there is no PKCS#1 style encoding for Ed25519 keys, they will always be
+// encoded in PKCS#8 format if no key encoding is specified.
 func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1alpha1.KeyEncoding) ([]byte, error) {
 	switch keyEncoding {
 	case v1alpha1.KeyEncoding(""), v1alpha1.PKCS1:
@@ -118,6 +130,11 @@ func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1alpha1.KeyEncoding) ([
 			return EncodePKCS1PrivateKey(k), nil
 		case *ecdsa.PrivateKey:
 			return EncodeECPrivateKey(k)
+		case ed25519.PrivateKey:
+			if keyEncoding == v1alpha1.PKCS1 {
+				return nil, fmt.Errorf("error encoding private key: ed25519 keys cannot be encoded using %s", keyEncoding)
+			}
+			return EncodePKCS8PrivateKey(k)
 		default:
 			return nil, fmt.Errorf("error encoding private key: unknown key type: %T", pk)
 		}
@@ -158,25 +175,85 @@ func EncodeECPrivateKey(pk *ecdsa.PrivateKey) ([]byte, error) {
 }
 
 // PublicKeyForPrivateKey will return the crypto.PublicKey for the given
-// crypto.PrivateKey. It only supports RSA and ECDSA keys.
+// crypto.PrivateKey. It only supports RSA, ECDSA and Ed25519 keys.
 func PublicKeyForPrivateKey(pk crypto.PrivateKey) (crypto.PublicKey, error) {
 	switch k := pk.(type) {
 	case *rsa.PrivateKey:
 		return k.Public(), nil
 	case *ecdsa.PrivateKey:
 		return k.Public(), nil
+	case ed25519.PrivateKey:
+		return k.Public(), nil
 	default:
 		return nil, fmt.Errorf("unknown private key type: %T", pk)
 	}
 }
 
+// PrivateKeyMatchesSpec will check that the given private key matches the key
+// algorithm and key size requested in the given Certificate spec.
+// It returns a list of human readable differences, which is empty if the
+// private key matches.
+func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec v1alpha1.CertificateSpec) []string {
+	var errs []string
+
+	switch spec.KeyAlgorithm {
+	case v1alpha1.KeyAlgorithm(""), v1alpha1.RSAKeyAlgorithm:
+		rsaPk, ok := pk.(*rsa.PrivateKey)
+		if !ok {
+			errs = append(errs, fmt.Sprintf("Private key algorithm not up to date: %q", keyAlgorithmForPrivateKey(pk)))
+			break
+		}
+		keySize := MinRSAKeySize
+		if spec.KeySize > 0 {
+			keySize = spec.KeySize
+		}
+		if rsaPk.N.BitLen() != keySize {
+			errs = append(errs, fmt.Sprintf("Private key size not up to date: %d", rsaPk.N.BitLen()))
+		}
+	case v1alpha1.ECDSAKeyAlgorithm:
+		ecdsaPk, ok := pk.(*ecdsa.PrivateKey)
+		if !ok {
+			errs = append(errs, fmt.Sprintf("Private key algorithm not up to date: %q", keyAlgorithmForPrivateKey(pk)))
+			break
+		}
+		keySize := ECCurve256
+		if spec.KeySize > 0 {
+			keySize = spec.KeySize
+		}
+		if ecdsaPk.Curve.Params().BitSize != keySize {
+			errs = append(errs, fmt.Sprintf("Private key size not up to date: %d", ecdsaPk.Curve.Params().BitSize))
+		}
+	case v1alpha1.Ed25519KeyAlgorithm:
+		if _, ok := pk.(ed25519.PrivateKey); !ok {
+			errs = append(errs, fmt.Sprintf("Private key algorithm not up to date: %q", keyAlgorithmForPrivateKey(pk)))
+		}
+	}
+
+	return errs
+}
+
+// keyAlgorithmForPrivateKey returns the name of the key algorithm of the
+// given private key, as used in the keyAlgorithm field of a Certificate.
+func keyAlgorithmForPrivateKey(pk crypto.PrivateKey) string {
+	switch pk.(type) {
+	case *rsa.PrivateKey:
+		return string(v1alpha1.RSAKeyAlgorithm)
+	case *ecdsa.PrivateKey:
+		return string(v1alpha1.ECDSAKeyAlgorithm)
+	case ed25519.PrivateKey:
+		return string(v1alpha1.Ed25519KeyAlgorithm)
+	default:
+		return fmt.Sprintf("%T", pk)
+	}
+}
+
 // PublicKeyMatchesCertificate can be used to verify the given public key
 // is the correct counter-part to the given x509 Certificate.
 // It will return false and no error if the public key is *not* valid for the
 // given Certificate.
 // It will return true if the public key *is* valid for the given Certificate.
 // It will return an error if either of the passed parameters are of an
-// unrecognised type (i.e. non RSA/ECDSA)
+// unrecognised type (i.e. non RSA/ECDSA/Ed25519)
 func PublicKeyMatchesCertificate(check crypto.PublicKey, crt *x509.Certificate) (bool, error) {
 	switch pub := crt.PublicKey.(type) {
 	case *rsa.PublicKey:
@@ -197,6 +274,12 @@ func PublicKeyMatchesCertificate(check crypto.PublicKey, crt *x509.Certificate)
 			return false, nil
 		}
 		return true, nil
+	case ed25519.PublicKey:
+		ed25519Check, ok := check.(ed25519.PublicKey)
+		if !ok {
+			return false, nil
+		}
+		return bytes.Equal(pub, ed25519Check), nil
 	default:
 		return false, fmt.Errorf("unrecognised Certificate public key type")
 	}
@@ -208,7 +291,7 @@ func PublicKeyMatchesCertificate(check crypto.PublicKey, crt *x509.Certificate)
 // given CertificateRequest.
 // It will return true if the public key *is* valid for the given CertificateRequest.
 // It will return an error if either of the passed parameters are of an
-// unrecognised type (i.e. non RSA/ECDSA)
+// unrecognised type (i.e. non RSA/ECDSA/Ed25519)
 func PublicKeyMatchesCSR(check crypto.PublicKey, csr *x509.CertificateRequest) (bool, error) {
 	switch pub := csr.PublicKey.(type) {
 	case *rsa.PublicKey:
@@ -229,6 +312,12 @@ func PublicKeyMatchesCSR(check crypto.PublicKey, csr *x509.CertificateRequest) (
 			return false, nil
 		}
 		return true, nil
+	case ed25519.PublicKey:
+		ed25519Check, ok := check.(ed25519.PublicKey)
+		if !ok {
+			return false, nil
+		}
+		return bytes.Equal(pub, ed25519Check), nil
 	default:
 		return false, fmt.Errorf("unrecognised Certificate public key type")
 	}
@@ -19,6 +19,7 @@ package pki
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -26,6 +27,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -125,6 +127,11 @@ func TestGeneratePrivateKeyForCertificate(t *testing.T) {
 			keySize:   521,
 			expectErr: false,
 		},
+		{
+			name:      "ed25519 key",
+			keyAlgo:   v1alpha1.Ed25519KeyAlgorithm,
+			expectErr: false,
+		},
 		{
 			name:      "valid key size with key algorithm not specified",
 			keyAlgo:   v1alpha1.KeyAlgorithm(""),
@@ -214,6 +221,13 @@ func TestGeneratePrivateKeyForCertificate(t *testing.T) {
 						return
 					}
 				}
+
+				if test.keyAlgo == "ed25519" {
+					if _, ok := privateKey.(ed25519.PrivateKey); !ok {
+						t.Errorf("expected ed25519 private key, but got %T", privateKey)
+						return
+					}
+				}
 			}
 		}
 	}
@@ -223,6 +237,88 @@ func TestGeneratePrivateKeyForCertificate(t *testing.T) {
 	}
 }
 
+func TestEd25519PrivateKey(t *testing.T) {
+	crt := buildCertificateWithKeyParams(v1alpha1.Ed25519KeyAlgorithm, 0)
+
+	key, err := GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		t.Fatalf("error generating private key: %v", err)
+	}
+
+	if _, err := EncodePrivateKey(key, v1alpha1.PKCS1); err == nil {
+		t.Errorf("expected error encoding ed25519 private key using pkcs1")
+	}
+
+	keyPEM, err := EncodePrivateKey(key, v1alpha1.KeyEncoding(""))
+	if err != nil {
+		t.Fatalf("error encoding private key: %v", err)
+	}
+	if block, _ := pem.Decode(keyPEM); block == nil || block.Type != "PRIVATE KEY" {
+		t.Fatalf("expected ed25519 private key to be encoded using pkcs8")
+	}
+
+	decodedKey, err := DecodePrivateKeyBytes(keyPEM)
+	if err != nil {
+		t.Fatalf("error decoding private key: %v", err)
+	}
+	if !key.(ed25519.PrivateKey).Equal(decodedKey) {
+		t.Errorf("expected decoded private key to equal the generated private key")
+	}
+
+	csrTemplate, err := GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatalf("error generating csr: %v", err)
+	}
+	csrDER, err := EncodeCSR(csrTemplate, key)
+	if err != nil {
+		t.Fatalf("error encoding csr: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		t.Fatalf("error parsing csr: %v", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		t.Errorf("error checking csr signature: %v", err)
+	}
+	if matches, err := PublicKeyMatchesCSR(key.Public(), csr); err != nil || !matches {
+		t.Errorf("expected private key to match csr, matches=%t err=%v", matches, err)
+	}
+
+	// sign an rsa leaf certificate using a self signed ed25519 CA
+	caCrt := crt.DeepCopy()
+	caCrt.Spec.IsCA = true
+	caTemplate, err := GenerateTemplate(caCrt)
+	if err != nil {
+		t.Fatalf("error generating template: %v", err)
+	}
+	_, caCert, err := SignCertificate(caTemplate, caTemplate, key.Public(), key)
+	if err != nil {
+		t.Fatalf("error signing ca certificate: %v", err)
+	}
+	if matches, err := PublicKeyMatchesCertificate(key.Public(), caCert); err != nil || !matches {
+		t.Errorf("expected private key to match certificate, matches=%t err=%v", matches, err)
+	}
+
+	leafKey, err := GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatalf("error generating private key: %v", err)
+	}
+	leafTemplate, err := GenerateTemplate(buildCertificateWithKeyParams(v1alpha1.RSAKeyAlgorithm, 2048))
+	if err != nil {
+		t.Fatalf("error generating template: %v", err)
+	}
+	_, leafCert, err := SignCertificate(leafTemplate, caCert, leafKey.Public(), key)
+	if err != nil {
+		t.Fatalf("error signing leaf certificate: %v", err)
+	}
+	if err := leafCert.CheckSignatureFrom(caCert); err != nil {
+		t.Errorf("error checking leaf certificate signature: %v", err)
+	}
+	if matches, err := PublicKeyMatchesCertificate(key.Public(), leafCert); err != nil || matches {
+		t.Errorf("expected ed25519 private key to not match rsa certificate, matches=%t err=%v", matches, err)
+	}
+}
+
 func signTestCert(key crypto.Signer) *x509.Certificate {
 	commonName := "testingcert"
 
@@ -450,3 +546,64 @@ O7WnDn8nuLFdW+NzzbIrTw==
 		t.Run(test.name, testFn(test))
 	}
 }
+
+func TestPrivateKeyMatchesSpec(t *testing.T) {
+	rsaKey, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	if err != nil {
+		t.Fatalf("error generating rsa private key: %v", err)
+	}
+	ecdsaKey, err := GenerateECPrivateKey(ECCurve384)
+	if err != nil {
+		t.Fatalf("error generating ecdsa private key: %v", err)
+	}
+	ed25519Key, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatalf("error generating ed25519 private key: %v", err)
+	}
+
+	tests := map[string]struct {
+		key  crypto.PrivateKey
+		spec v1alpha1.CertificateSpec
+		errs []string
+	}{
+		"rsa key with default spec": {
+			key: rsaKey,
+		},
+		"rsa key with larger key size": {
+			key:  rsaKey,
+			spec: v1alpha1.CertificateSpec{KeyAlgorithm: v1alpha1.RSAKeyAlgorithm, KeySize: 4096},
+			errs: []string{"Private key size not up to date: 2048"},
+		},
+		"rsa key with ecdsa key algorithm": {
+			key:  rsaKey,
+			spec: v1alpha1.CertificateSpec{KeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm},
+			errs: []string{`Private key algorithm not up to date: "rsa"`},
+		},
+		"ecdsa key with matching key size": {
+			key:  ecdsaKey,
+			spec: v1alpha1.CertificateSpec{KeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm, KeySize: 384},
+		},
+		"ecdsa key with default key size": {
+			key:  ecdsaKey,
+			spec: v1alpha1.CertificateSpec{KeyAlgorithm: v1alpha1.ECDSAKeyAlgorithm},
+			errs: []string{"Private key size not up to date: 384"},
+		},
+		"ed25519 key with ed25519 key algorithm": {
+			key:  ed25519Key,
+			spec: v1alpha1.CertificateSpec{KeyAlgorithm: v1alpha1.Ed25519KeyAlgorithm},
+		},
+		"ed25519 key with default spec": {
+			key:  ed25519Key,
+			errs: []string{`Private key algorithm not up to date: "ed25519"`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := PrivateKeyMatchesSpec(test.key, test.spec)
+			if !reflect.DeepEqual(errs, test.errs) {
+				t.Errorf("unexpected differences, exp=%v got=%v", test.errs, errs)
+			}
+		})
+	}
+}
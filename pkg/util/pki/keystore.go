@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	jks "github.com/pavel-v-chernykh/keystore-go"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	// jksKeyAlias is the alias used for the private key entry stored in JKS
+	// keystores.
+	jksKeyAlias = "certificate"
+
+	// jksCAAlias is the alias used for the first CA certificate entry stored
+	// in JKS truststores.
+	jksCAAlias = "ca"
+)
+
+// EncodePKCS12Keystore will encode a PKCS#12 keystore using the given
+// password, containing the PEM encoded private key and certificate chain.
+// If ca is not empty, the CA certificates will also be added to the keystore.
+func EncodePKCS12Keystore(password string, rawKey []byte, certPem []byte, caPem []byte) ([]byte, error) {
+	key, err := DecodePrivateKeyBytes(rawKey)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := DecodeX509CertificateChainBytes(certPem)
+	if err != nil {
+		return nil, err
+	}
+	var cas []*x509.Certificate
+	if len(caPem) > 0 {
+		cas, err = DecodeX509CertificateChainBytes(caPem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// prepend the certificate chain to the list of certificates as the PKCS12
+	// library only allows setting a single certificate.
+	if len(certs) > 1 {
+		cas = append(certs[1:], cas...)
+	}
+	return pkcs12.Encode(rand.Reader, key, certs[0], cas, password)
+}
+
+// EncodeJKSKeystore will encode a JKS keystore using the given password,
+// containing the PEM encoded private key and certificate chain.
+func EncodeJKSKeystore(password []byte, rawKey []byte, certPem []byte) ([]byte, error) {
+	key, err := DecodePrivateKeyBytes(rawKey)
+	if err != nil {
+		return nil, err
+	}
+	// JKS keystores store private keys in PKCS#8 form regardless of the
+	// encoding used in the Secret
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := DecodeX509CertificateChainBytes(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := make([]jks.Certificate, len(certs))
+	for i, c := range certs {
+		chain[i] = jks.Certificate{
+			Type:    "X509",
+			Content: c.Raw,
+		}
+	}
+
+	ks := jks.KeyStore{
+		jksKeyAlias: &jks.PrivateKeyEntry{
+			Entry: jks.Entry{
+				CreationDate: time.Now(),
+			},
+			PrivKey:   keyDER,
+			CertChain: chain,
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jks.Encode(buf, ks, password); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeJKSTruststore will encode a JKS truststore using the given password,
+// containing each of the PEM encoded CA certificates as a trusted certificate
+// entry. The first CA certificate is stored with the alias 'ca', and any
+// further CA certificates with the aliases 'ca-1', 'ca-2' and so on.
+func EncodeJKSTruststore(password []byte, caPem []byte) ([]byte, error) {
+	cas, err := DecodeX509CertificateChainBytes(caPem)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := jks.KeyStore{}
+	for i, ca := range cas {
+		alias := jksCAAlias
+		if i > 0 {
+			alias = fmt.Sprintf("%s-%d", jksCAAlias, i)
+		}
+		ks[alias] = &jks.TrustedCertificateEntry{
+			Entry: jks.Entry{
+				CreationDate: time.Now(),
+			},
+			Certificate: jks.Certificate{
+				Type:    "X509",
+				Content: ca.Raw,
+			},
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jks.Encode(buf, ks, password); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// VerifyPKCS12Keystore will return an error if the given PKCS#12 keystore
+// cannot be decoded using the given password.
+func VerifyPKCS12Keystore(data []byte, password string) error {
+	if _, err := pkcs12.ToPEM(data, password); err != nil {
+		return fmt.Errorf("error decoding PKCS#12 keystore: %v", err)
+	}
+	return nil
+}
+
+// VerifyJKSKeystore will return an error if the given JKS keystore or
+// truststore cannot be decoded using the given password.
+func VerifyJKSKeystore(data []byte, password []byte) error {
+	if _, err := jks.Decode(bytes.NewReader(data), password); err != nil {
+		return fmt.Errorf("error decoding JKS keystore: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"testing"
+
+	jks "github.com/pavel-v-chernykh/keystore-go"
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestKeystores(t *testing.T) {
+	for _, keyAlgo := range []v1alpha1.KeyAlgorithm{v1alpha1.RSAKeyAlgorithm, v1alpha1.ECDSAKeyAlgorithm} {
+		t.Run(string(keyAlgo), func(t *testing.T) {
+			cert := buildCertificateWithKeyParams(keyAlgo, 0)
+			key, err := GeneratePrivateKeyForCertificate(cert)
+			if err != nil {
+				t.Fatalf("error generating private key: %v", err)
+			}
+			keyPEM, err := EncodePrivateKey(key, cert.Spec.KeyEncoding)
+			if err != nil {
+				t.Fatalf("error encoding private key: %v", err)
+			}
+			template, err := GenerateTemplate(cert)
+			if err != nil {
+				t.Fatalf("error generating template: %v", err)
+			}
+			certPEM, _, err := SignCertificate(template, template, key.Public(), key)
+			if err != nil {
+				t.Fatalf("error signing certificate: %v", err)
+			}
+
+			p12, err := EncodePKCS12Keystore("password", keyPEM, certPEM, certPEM)
+			if err != nil {
+				t.Fatalf("error encoding PKCS#12 keystore: %v", err)
+			}
+			if err := VerifyPKCS12Keystore(p12, "password"); err != nil {
+				t.Errorf("expected PKCS#12 keystore to be decoded: %v", err)
+			}
+			if err := VerifyPKCS12Keystore(p12, "wrong"); err == nil {
+				t.Errorf("expected PKCS#12 keystore not to be decoded using the wrong password")
+			}
+			blocks, err := pkcs12.ToPEM(p12, "password")
+			if err != nil {
+				t.Fatalf("error decoding PKCS#12 keystore: %v", err)
+			}
+			// private key, certificate and CA
+			if len(blocks) != 3 {
+				t.Errorf("expected 3 entries in PKCS#12 keystore, got %d", len(blocks))
+			}
+
+			ks, err := EncodeJKSKeystore([]byte("password"), keyPEM, certPEM)
+			if err != nil {
+				t.Fatalf("error encoding JKS keystore: %v", err)
+			}
+			if err := VerifyJKSKeystore(ks, []byte("password")); err != nil {
+				t.Errorf("expected JKS keystore to be decoded: %v", err)
+			}
+			if err := VerifyJKSKeystore(ks, []byte("wrong")); err == nil {
+				t.Errorf("expected JKS keystore not to be decoded using the wrong password")
+			}
+			decoded, err := jks.Decode(bytes.NewReader(ks), []byte("password"))
+			if err != nil {
+				t.Fatalf("error decoding JKS keystore: %v", err)
+			}
+			entry, ok := decoded[jksKeyAlias].(*jks.PrivateKeyEntry)
+			if !ok {
+				t.Fatalf("expected JKS keystore to contain a private key entry")
+			}
+			if len(entry.CertChain) != 1 {
+				t.Errorf("expected 1 certificate in JKS keystore chain, got %d", len(entry.CertChain))
+			}
+
+			ts, err := EncodeJKSTruststore([]byte("password"), certPEM)
+			if err != nil {
+				t.Fatalf("error encoding JKS truststore: %v", err)
+			}
+			if err := VerifyJKSKeystore(ts, []byte("password")); err != nil {
+				t.Errorf("expected JKS truststore to be decoded: %v", err)
+			}
+		})
+	}
+}
+
+func TestKeystoresWithMultipleCAs(t *testing.T) {
+	var caPEM, keyPEM, certPEM []byte
+	for i := 0; i < 3; i++ {
+		cert := buildCertificateWithKeyParams(v1alpha1.ECDSAKeyAlgorithm, 0)
+		key, err := GeneratePrivateKeyForCertificate(cert)
+		if err != nil {
+			t.Fatalf("error generating private key: %v", err)
+		}
+		template, err := GenerateTemplate(cert)
+		if err != nil {
+			t.Fatalf("error generating template: %v", err)
+		}
+		pemBytes, _, err := SignCertificate(template, template, key.Public(), key)
+		if err != nil {
+			t.Fatalf("error signing certificate: %v", err)
+		}
+		if i == 0 {
+			keyPEM, err = EncodePrivateKey(key, cert.Spec.KeyEncoding)
+			if err != nil {
+				t.Fatalf("error encoding private key: %v", err)
+			}
+			certPEM = pemBytes
+			continue
+		}
+		caPEM = append(caPEM, pemBytes...)
+	}
+
+	ts, err := EncodeJKSTruststore([]byte("password"), caPEM)
+	if err != nil {
+		t.Fatalf("error encoding JKS truststore: %v", err)
+	}
+	decoded, err := jks.Decode(bytes.NewReader(ts), []byte("password"))
+	if err != nil {
+		t.Fatalf("error decoding JKS truststore: %v", err)
+	}
+	if len(decoded) != 2 {
+		t.Errorf("expected 2 entries in JKS truststore, got %d", len(decoded))
+	}
+	for _, alias := range []string{jksCAAlias, jksCAAlias + "-1"} {
+		if _, ok := decoded[alias].(*jks.TrustedCertificateEntry); !ok {
+			t.Errorf("expected JKS truststore to contain a trusted certificate entry %q", alias)
+		}
+	}
+
+	p12, err := EncodePKCS12Keystore("password", keyPEM, certPEM, caPEM)
+	if err != nil {
+		t.Fatalf("error encoding PKCS#12 keystore: %v", err)
+	}
+	blocks, err := pkcs12.ToPEM(p12, "password")
+	if err != nil {
+		t.Fatalf("error decoding PKCS#12 keystore: %v", err)
+	}
+	// private key, certificate and both CAs
+	if len(blocks) != 4 {
+		t.Errorf("expected 4 entries in PKCS#12 keystore, got %d", len(blocks))
+	}
+}
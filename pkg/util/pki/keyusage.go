@@ -0,0 +1,359 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+var (
+	// OIDExtensionKeyUsage is the object identifier of the x509 key usage
+	// extension, as defined in RFC 5280 section 4.2.1.3.
+	OIDExtensionKeyUsage = asn1.ObjectIdentifier{2, 5, 29, 15}
+	// OIDExtensionExtendedKeyUsage is the object identifier of the x509
+	// extended key usage extension, as defined in RFC 5280 section 4.2.1.12.
+	OIDExtensionExtendedKeyUsage = asn1.ObjectIdentifier{2, 5, 29, 37}
+	// OIDExtensionBasicConstraints is the object identifier of the x509
+	// basic constraints extension, as defined in RFC 5280 section 4.2.1.9.
+	OIDExtensionBasicConstraints = asn1.ObjectIdentifier{2, 5, 29, 19}
+)
+
+var keyUsages = map[v1alpha1.KeyUsage]x509.KeyUsage{
+	v1alpha1.UsageSigning:           x509.KeyUsageDigitalSignature,
+	v1alpha1.UsageDigitalSignature:  x509.KeyUsageDigitalSignature,
+	v1alpha1.UsageContentCommitment: x509.KeyUsageContentCommitment,
+	v1alpha1.UsageKeyEncipherment:   x509.KeyUsageKeyEncipherment,
+	v1alpha1.UsageKeyAgreement:      x509.KeyUsageKeyAgreement,
+	v1alpha1.UsageDataEncipherment:  x509.KeyUsageDataEncipherment,
+	v1alpha1.UsageCertSign:          x509.KeyUsageCertSign,
+	v1alpha1.UsageCRLSign:           x509.KeyUsageCRLSign,
+	v1alpha1.UsageEncipherOnly:      x509.KeyUsageEncipherOnly,
+	v1alpha1.UsageDecipherOnly:      x509.KeyUsageDecipherOnly,
+}
+
+var extKeyUsages = map[v1alpha1.KeyUsage]x509.ExtKeyUsage{
+	v1alpha1.UsageAny:             x509.ExtKeyUsageAny,
+	v1alpha1.UsageServerAuth:      x509.ExtKeyUsageServerAuth,
+	v1alpha1.UsageClientAuth:      x509.ExtKeyUsageClientAuth,
+	v1alpha1.UsageCodeSigning:     x509.ExtKeyUsageCodeSigning,
+	v1alpha1.UsageEmailProtection: x509.ExtKeyUsageEmailProtection,
+	v1alpha1.UsageSMIME:           x509.ExtKeyUsageEmailProtection,
+	v1alpha1.UsageIPsecEndSystem:  x509.ExtKeyUsageIPSECEndSystem,
+	v1alpha1.UsageIPsecTunnel:     x509.ExtKeyUsageIPSECTunnel,
+	v1alpha1.UsageIPsecUser:       x509.ExtKeyUsageIPSECUser,
+	v1alpha1.UsageTimestamping:    x509.ExtKeyUsageTimeStamping,
+	v1alpha1.UsageOCSPSigning:     x509.ExtKeyUsageOCSPSigning,
+	v1alpha1.UsageMicrosoftSGC:    x509.ExtKeyUsageMicrosoftServerGatedCrypto,
+	v1alpha1.UsageNetscapeSGC:     x509.ExtKeyUsageNetscapeServerGatedCrypto,
+}
+
+// extKeyUsageOIDs maps extended key usages to their object identifiers, as
+// defined in RFC 5280 section 4.2.1.12 and by the respective vendors.
+var extKeyUsageOIDs = map[x509.ExtKeyUsage]asn1.ObjectIdentifier{
+	x509.ExtKeyUsageAny:                        {2, 5, 29, 37, 0},
+	x509.ExtKeyUsageServerAuth:                 {1, 3, 6, 1, 5, 5, 7, 3, 1},
+	x509.ExtKeyUsageClientAuth:                 {1, 3, 6, 1, 5, 5, 7, 3, 2},
+	x509.ExtKeyUsageCodeSigning:                {1, 3, 6, 1, 5, 5, 7, 3, 3},
+	x509.ExtKeyUsageEmailProtection:            {1, 3, 6, 1, 5, 5, 7, 3, 4},
+	x509.ExtKeyUsageIPSECEndSystem:             {1, 3, 6, 1, 5, 5, 7, 3, 5},
+	x509.ExtKeyUsageIPSECTunnel:                {1, 3, 6, 1, 5, 5, 7, 3, 6},
+	x509.ExtKeyUsageIPSECUser:                  {1, 3, 6, 1, 5, 5, 7, 3, 7},
+	x509.ExtKeyUsageTimeStamping:               {1, 3, 6, 1, 5, 5, 7, 3, 8},
+	x509.ExtKeyUsageOCSPSigning:                {1, 3, 6, 1, 5, 5, 7, 3, 9},
+	x509.ExtKeyUsageMicrosoftServerGatedCrypto: {1, 3, 6, 1, 4, 1, 311, 10, 3, 3},
+	x509.ExtKeyUsageNetscapeServerGatedCrypto:  {2, 16, 840, 1, 113730, 4, 1},
+}
+
+// KeyUsageType returns the x509.KeyUsage for the given usage, and false if
+// the usage is not a key usage (e.g. it is an extended key usage).
+func KeyUsageType(usage v1alpha1.KeyUsage) (x509.KeyUsage, bool) {
+	ku, ok := keyUsages[usage]
+	return ku, ok
+}
+
+// ExtKeyUsageType returns the x509.ExtKeyUsage for the given usage, and false
+// if the usage is not an extended key usage.
+func ExtKeyUsageType(usage v1alpha1.KeyUsage) (x509.ExtKeyUsage, bool) {
+	eku, ok := extKeyUsages[usage]
+	return eku, ok
+}
+
+// BuildKeyUsages will return the x509.KeyUsage and list of x509.ExtKeyUsage
+// to be set on a certificate given the list of usages on a Certificate or
+// CertificateRequest resource.
+// If no usages are given, v1alpha1.DefaultKeyUsages will be used.
+// If isCA is true, x509.KeyUsageCertSign will always be set.
+func BuildKeyUsages(usages []v1alpha1.KeyUsage, isCA bool) (x509.KeyUsage, []x509.ExtKeyUsage, error) {
+	if len(usages) == 0 {
+		usages = v1alpha1.DefaultKeyUsages()
+	}
+
+	var ku x509.KeyUsage
+	var eku []x509.ExtKeyUsage
+	for _, u := range usages {
+		if kuse, ok := KeyUsageType(u); ok {
+			ku |= kuse
+			continue
+		}
+		if ekuse, ok := ExtKeyUsageType(u); ok {
+			eku = appendExtKeyUsage(eku, ekuse)
+			continue
+		}
+		return 0, nil, fmt.Errorf("unrecognised key usage: %q", u)
+	}
+
+	if isCA {
+		ku |= x509.KeyUsageCertSign
+	}
+
+	return ku, eku, nil
+}
+
+func appendExtKeyUsage(eku []x509.ExtKeyUsage, e x509.ExtKeyUsage) []x509.ExtKeyUsage {
+	for _, existing := range eku {
+		if existing == e {
+			return eku
+		}
+	}
+	return append(eku, e)
+}
+
+// KeyUsagesForCertificate returns the x509.KeyUsage and list of
+// x509.ExtKeyUsage that should be used for the given Certificate resource.
+func KeyUsagesForCertificate(crt *v1alpha1.Certificate) (x509.KeyUsage, []x509.ExtKeyUsage, error) {
+	return BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+}
+
+// BuildKeyUsageExtensions returns the key usage and extended key usage
+// extensions to be embedded into a CSR that requests the given usages.
+// The extended key usage extension will be omitted if no extended key
+// usages are given.
+func BuildKeyUsageExtensions(ku x509.KeyUsage, eku []x509.ExtKeyUsage) ([]pkix.Extension, error) {
+	var exts []pkix.Extension
+
+	kuExt, err := marshalKeyUsage(ku)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode key usage extension: %s", err)
+	}
+	exts = append(exts, kuExt)
+
+	if len(eku) > 0 {
+		ekuExt, err := marshalExtKeyUsage(eku)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode extended key usage extension: %s", err)
+		}
+		exts = append(exts, ekuExt)
+	}
+
+	return exts, nil
+}
+
+// KeyUsagesForCSR returns the key usages and extended key usages requested
+// in the extensions of the given CSR.
+// Extended key usages with unrecognised object identifiers are skipped, see
+// UnknownExtKeyUsagesForCSR.
+// The boolean return value will be false if the CSR does not request any
+// usages.
+func KeyUsagesForCSR(csr *x509.CertificateRequest) (x509.KeyUsage, []x509.ExtKeyUsage, bool, error) {
+	var ku x509.KeyUsage
+	var eku []x509.ExtKeyUsage
+	found := false
+	for _, ext := range csr.Extensions {
+		switch {
+		case ext.Id.Equal(OIDExtensionKeyUsage):
+			var err error
+			ku, err = unmarshalKeyUsage(ext.Value)
+			if err != nil {
+				return 0, nil, false, fmt.Errorf("failed to decode key usage extension: %s", err)
+			}
+			found = true
+		case ext.Id.Equal(OIDExtensionExtendedKeyUsage):
+			var err error
+			eku, _, err = unmarshalExtKeyUsage(ext.Value)
+			if err != nil {
+				return 0, nil, false, fmt.Errorf("failed to decode extended key usage extension: %s", err)
+			}
+			found = true
+		}
+	}
+	return ku, eku, found, nil
+}
+
+// UnknownExtKeyUsagesForCSR returns the object identifiers of any extended
+// key usages requested in the extensions of the given CSR that are not
+// recognised, and so will not be set on the signed certificate.
+func UnknownExtKeyUsagesForCSR(csr *x509.CertificateRequest) ([]asn1.ObjectIdentifier, error) {
+	for _, ext := range csr.Extensions {
+		if !ext.Id.Equal(OIDExtensionExtendedKeyUsage) {
+			continue
+		}
+		_, unknown, err := unmarshalExtKeyUsage(ext.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode extended key usage extension: %s", err)
+		}
+		return unknown, nil
+	}
+	return nil, nil
+}
+
+// basicConstraints is the ASN.1 structure of the basic constraints extension.
+type basicConstraints struct {
+	IsCA       bool `asn1:"optional"`
+	MaxPathLen int  `asn1:"optional,default:-1"`
+}
+
+// IsCAForCSR returns whether the given CSR requests a CA certificate in its
+// basic constraints extension.
+// The second return value will be false if the CSR does not contain a basic
+// constraints extension.
+func IsCAForCSR(csr *x509.CertificateRequest) (bool, bool, error) {
+	for _, ext := range csr.Extensions {
+		if !ext.Id.Equal(OIDExtensionBasicConstraints) {
+			continue
+		}
+		var constraints basicConstraints
+		rest, err := asn1.Unmarshal(ext.Value, &constraints)
+		if err != nil {
+			return false, false, fmt.Errorf("failed to decode basic constraints extension: %s", err)
+		}
+		if len(rest) != 0 {
+			return false, false, fmt.Errorf("failed to decode basic constraints extension: trailing data")
+		}
+		return constraints.IsCA, true, nil
+	}
+	return false, false, nil
+}
+
+func marshalKeyUsage(ku x509.KeyUsage) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: OIDExtensionKeyUsage, Critical: true}
+
+	// x509.KeyUsage bit 0 is the most significant bit of the first byte of
+	// the encoded BIT STRING, so the bits of each byte must be reversed.
+	var a [2]byte
+	a[0] = reverseBitsInAByte(byte(ku))
+	a[1] = reverseBitsInAByte(byte(ku >> 8))
+
+	l := 1
+	if a[1] != 0 {
+		l = 2
+	}
+
+	bitString := a[:l]
+	var err error
+	ext.Value, err = asn1.Marshal(asn1.BitString{Bytes: bitString, BitLength: asn1BitLength(bitString)})
+	if err != nil {
+		return ext, err
+	}
+
+	return ext, nil
+}
+
+func unmarshalKeyUsage(value []byte) (x509.KeyUsage, error) {
+	var asn1bits asn1.BitString
+	rest, err := asn1.Unmarshal(value, &asn1bits)
+	if err != nil {
+		return 0, err
+	}
+	if len(rest) != 0 {
+		return 0, fmt.Errorf("trailing data after key usage")
+	}
+
+	var ku int
+	for i := 0; i < 9; i++ {
+		if asn1bits.At(i) != 0 {
+			ku |= 1 << uint(i)
+		}
+	}
+	return x509.KeyUsage(ku), nil
+}
+
+func marshalExtKeyUsage(eku []x509.ExtKeyUsage) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: OIDExtensionExtendedKeyUsage}
+
+	oids := make([]asn1.ObjectIdentifier, len(eku))
+	for i, u := range eku {
+		oid, ok := extKeyUsageOIDs[u]
+		if !ok {
+			return ext, fmt.Errorf("unknown extended key usage: %d", u)
+		}
+		oids[i] = oid
+	}
+
+	var err error
+	ext.Value, err = asn1.Marshal(oids)
+	if err != nil {
+		return ext, err
+	}
+
+	return ext, nil
+}
+
+func unmarshalExtKeyUsage(value []byte) ([]x509.ExtKeyUsage, []asn1.ObjectIdentifier, error) {
+	var oids []asn1.ObjectIdentifier
+	rest, err := asn1.Unmarshal(value, &oids)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rest) != 0 {
+		return nil, nil, fmt.Errorf("trailing data after extended key usage")
+	}
+
+	var eku []x509.ExtKeyUsage
+	var unknown []asn1.ObjectIdentifier
+Outer:
+	for _, oid := range oids {
+		for u, uOID := range extKeyUsageOIDs {
+			if oid.Equal(uOID) {
+				eku = append(eku, u)
+				continue Outer
+			}
+		}
+		unknown = append(unknown, oid)
+	}
+	return eku, unknown, nil
+}
+
+func reverseBitsInAByte(in byte) byte {
+	b1 := in>>4 | in<<4
+	b2 := b1>>2&0x33 | b1<<2&0xcc
+	b3 := b2>>1&0x55 | b2<<1&0xaa
+	return b3
+}
+
+// asn1BitLength returns the bit-length of bitString by considering the
+// most-significant bit in a byte to be the "first" bit.
+func asn1BitLength(bitString []byte) int {
+	bitLen := len(bitString) * 8
+
+	for i := range bitString {
+		b := bitString[len(bitString)-i-1]
+
+		for bit := uint(0); bit < 8; bit++ {
+			if (b>>bit)&1 == 1 {
+				return bitLen
+			}
+			bitLen--
+		}
+	}
+
+	return 0
+}
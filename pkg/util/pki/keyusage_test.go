@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"reflect"
+	"testing"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+func TestBuildKeyUsages(t *testing.T) {
+	type testT struct {
+		name      string
+		usages    []v1alpha1.KeyUsage
+		isCA      bool
+		expectKU  x509.KeyUsage
+		expectEKU []x509.ExtKeyUsage
+		expectErr bool
+	}
+	tests := []testT{
+		{
+			name:     "no usages set should use defaults",
+			expectKU: x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		},
+		{
+			name:     "no usages set on a CA should use defaults and cert sign",
+			isCA:     true,
+			expectKU: x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		},
+		{
+			name:      "client auth only",
+			usages:    []v1alpha1.KeyUsage{v1alpha1.UsageDigitalSignature, v1alpha1.UsageClientAuth},
+			expectKU:  x509.KeyUsageDigitalSignature,
+			expectEKU: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+		{
+			name:      "duplicate extended key usages are removed",
+			usages:    []v1alpha1.KeyUsage{v1alpha1.UsageEmailProtection, v1alpha1.UsageSMIME},
+			expectEKU: []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection},
+		},
+		{
+			name:      "unknown usage",
+			usages:    []v1alpha1.KeyUsage{"nonsense"},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ku, eku, err := BuildKeyUsages(test.usages, test.isCA)
+			if test.expectErr != (err != nil) {
+				t.Errorf("expected error=%t but got: %v", test.expectErr, err)
+				return
+			}
+			if ku != test.expectKU {
+				t.Errorf("expected key usage %v but got %v", test.expectKU, ku)
+			}
+			if !reflect.DeepEqual(eku, test.expectEKU) {
+				t.Errorf("expected extended key usages %v but got %v", test.expectEKU, eku)
+			}
+		})
+	}
+}
+
+func TestKeyUsagesForCSR(t *testing.T) {
+	crt := buildCertificate("test")
+	crt.Spec.Usages = []v1alpha1.KeyUsage{
+		v1alpha1.UsageDigitalSignature,
+		v1alpha1.UsageCRLSign,
+		v1alpha1.UsageDecipherOnly,
+		v1alpha1.UsageServerAuth,
+		v1alpha1.UsageCodeSigning,
+	}
+
+	template, err := GenerateCSR(nil, crt)
+	if err != nil {
+		t.Fatalf("failed to generate csr template: %v", err)
+	}
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+	template.SignatureAlgorithm = x509.ECDSAWithSHA256
+	csrDER, err := EncodeCSR(template, pk)
+	if err != nil {
+		t.Fatalf("failed to encode csr: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		t.Fatalf("failed to parse csr: %v", err)
+	}
+
+	ku, eku, found, err := KeyUsagesForCSR(csr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !found {
+		t.Fatalf("expected usages to be found in csr extensions")
+	}
+	expKU := x509.KeyUsageDigitalSignature | x509.KeyUsageCRLSign | x509.KeyUsageDecipherOnly
+	if ku != expKU {
+		t.Errorf("expected key usage %v but got %v", expKU, ku)
+	}
+	expEKU := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageCodeSigning}
+	if !reflect.DeepEqual(eku, expEKU) {
+		t.Errorf("expected extended key usages %v but got %v", expEKU, eku)
+	}
+}
+
+func TestKeyUsagesForCSRWithUnknownExtKeyUsage(t *testing.T) {
+	unknownOID := asn1.ObjectIdentifier{1, 2, 3, 4}
+	ekuValue, err := asn1.Marshal([]asn1.ObjectIdentifier{
+		extKeyUsageOIDs[x509.ExtKeyUsageServerAuth],
+		unknownOID,
+	})
+	if err != nil {
+		t.Fatalf("failed to encode extended key usages: %v", err)
+	}
+	csr := &x509.CertificateRequest{
+		Extensions: []pkix.Extension{{Id: OIDExtensionExtendedKeyUsage, Value: ekuValue}},
+	}
+
+	_, eku, found, err := KeyUsagesForCSR(csr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !found {
+		t.Errorf("expected usages to be found in csr extensions")
+	}
+	expEKU := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	if !reflect.DeepEqual(eku, expEKU) {
+		t.Errorf("expected extended key usages %v but got %v", expEKU, eku)
+	}
+
+	unknown, err := UnknownExtKeyUsagesForCSR(csr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(unknown) != 1 || !unknown[0].Equal(unknownOID) {
+		t.Errorf("expected unknown extended key usages %v but got %v", []asn1.ObjectIdentifier{unknownOID}, unknown)
+	}
+}
+
+func TestKeyUsagesForCertificateRequestDefaults(t *testing.T) {
+	cr := &v1alpha1.CertificateRequest{}
+	ku, eku, err := KeyUsagesForCertificateRequest(cr, &x509.CertificateRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expKU := x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	if ku != expKU {
+		t.Errorf("expected key usage %v but got %v", expKU, ku)
+	}
+	expEKU := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	if !reflect.DeepEqual(eku, expEKU) {
+		t.Errorf("expected extended key usages %v but got %v", expEKU, eku)
+	}
+}
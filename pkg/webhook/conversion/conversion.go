@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion implements a CustomResourceDefinition conversion webhook
+// that converts cert-manager resources between the versions of the
+// certmanager.k8s.io API group.
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/klog"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/install"
+)
+
+// Handler is a http.Handler that serves ConversionReview requests sent by
+// the Kubernetes apiserver.
+type Handler struct {
+	scheme  *runtime.Scheme
+	decoder runtime.Decoder
+}
+
+var _ http.Handler = &Handler{}
+
+// NewHandler returns a Handler that converts resources between all versions
+// of the certmanager.k8s.io API group.
+func NewHandler() *Handler {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	return &Handler{
+		scheme:  scheme,
+		decoder: serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("unsupported method %q", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review := &apiext.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil {
+		http.Error(w, fmt.Sprintf("error decoding ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "ConversionReview does not contain a request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.Convert(review.Request)
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		klog.Errorf("error writing ConversionReview response: %v", err)
+	}
+}
+
+// Convert converts all of the objects in the given ConversionRequest to the
+// desired API version. If any object cannot be converted, a failure result is
+// returned and no objects are converted.
+func (h *Handler) Convert(req *apiext.ConversionRequest) *apiext.ConversionResponse {
+	resp := &apiext.ConversionResponse{
+		UID: req.UID,
+	}
+
+	converted, err := h.convertObjects(req.Objects, req.DesiredAPIVersion)
+	if err != nil {
+		klog.Errorf("error converting objects to %q: %v", req.DesiredAPIVersion, err)
+		resp.Result = metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+		}
+		return resp
+	}
+
+	resp.ConvertedObjects = converted
+	resp.Result = metav1.Status{
+		Status: metav1.StatusSuccess,
+	}
+	return resp
+}
+
+func (h *Handler) convertObjects(objects []runtime.RawExtension, desiredAPIVersion string) ([]runtime.RawExtension, error) {
+	gv, err := schema.ParseGroupVersion(desiredAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	if gv.Group != certmanager.GroupName {
+		return nil, fmt.Errorf("unsupported API group %q", gv.Group)
+	}
+
+	converted := make([]runtime.RawExtension, len(objects))
+	for i, raw := range objects {
+		obj, err := runtime.Decode(h.decoder, raw.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding object: %v", err)
+		}
+		out, err := h.scheme.ConvertToVersion(obj, gv)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(out)
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = runtime.RawExtension{Raw: data}
+	}
+
+	return converted, nil
+}
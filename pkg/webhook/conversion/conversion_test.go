@@ -0,0 +1,308 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha2"
+)
+
+func mustMarshal(t *testing.T, obj interface{}) runtime.RawExtension {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("error marshaling object: %v", err)
+	}
+	return runtime.RawExtension{Raw: data}
+}
+
+func convert(t *testing.T, h *Handler, obj runtime.Object, desiredAPIVersion string, into runtime.Object) {
+	resp := h.Convert(&apiext.ConversionRequest{
+		UID:               types.UID("test"),
+		DesiredAPIVersion: desiredAPIVersion,
+		Objects:           []runtime.RawExtension{mustMarshal(t, obj)},
+	})
+	if resp.UID != "test" {
+		t.Errorf("expected response UID %q, got %q", "test", resp.UID)
+	}
+	if resp.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("expected conversion to succeed, got: %s", resp.Result.Message)
+	}
+	if len(resp.ConvertedObjects) != 1 {
+		t.Fatalf("expected 1 converted object, got %d", len(resp.ConvertedObjects))
+	}
+	if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, into); err != nil {
+		t.Fatalf("error decoding converted object: %v", err)
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	typeMeta := func(kind string) metav1.TypeMeta {
+		return metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: kind}
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:        "test",
+		Namespace:   "default-unit-test-ns",
+		Annotations: map[string]string{"test": "annotation"},
+	}
+	acmeIssuerSpec := v1alpha1.IssuerSpec{
+		IssuerConfig: v1alpha1.IssuerConfig{
+			ACME: &v1alpha1.ACMEIssuer{
+				Server: "https://acme-v02.api.letsencrypt.org/directory",
+				HTTP01: &v1alpha1.ACMEIssuerHTTP01Config{},
+				DNS01: &v1alpha1.ACMEIssuerDNS01Config{
+					Providers: []v1alpha1.ACMEIssuerDNS01Provider{
+						{Name: "cloudflare", Cloudflare: &v1alpha1.ACMEIssuerDNS01ProviderCloudflare{Email: "test@example.com"}},
+					},
+				},
+			},
+		},
+	}
+	solverConfig := v1alpha1.SolverConfig{
+		DNS01: &v1alpha1.DNS01SolverConfig{Provider: "cloudflare"},
+	}
+
+	tests := map[string]struct {
+		in  runtime.Object
+		v2  runtime.Object
+		out runtime.Object
+	}{
+		"Certificate with deprecated acme config": {
+			in: &v1alpha1.Certificate{
+				TypeMeta:   typeMeta(v1alpha1.CertificateKind),
+				ObjectMeta: objectMeta,
+				Spec: v1alpha1.CertificateSpec{
+					SecretName: "test",
+					DNSNames:   []string{"example.com"},
+					ACME: &v1alpha1.ACMECertificateConfig{
+						Config: []v1alpha1.DomainSolverConfig{
+							{Domains: []string{"example.com"}, SolverConfig: solverConfig},
+						},
+					},
+				},
+			},
+			v2:  &v1alpha2.Certificate{},
+			out: &v1alpha1.Certificate{},
+		},
+		"Certificate without deprecated config": {
+			in: &v1alpha1.Certificate{
+				TypeMeta:   typeMeta(v1alpha1.CertificateKind),
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1alpha1.CertificateSpec{
+					SecretName: "test",
+					CommonName: "example.com",
+				},
+			},
+			v2:  &v1alpha2.Certificate{},
+			out: &v1alpha1.Certificate{},
+		},
+		"Issuer with deprecated http01 and dns01 config": {
+			in: &v1alpha1.Issuer{
+				TypeMeta:   typeMeta(v1alpha1.IssuerKind),
+				ObjectMeta: objectMeta,
+				Spec:       acmeIssuerSpec,
+			},
+			v2:  &v1alpha2.Issuer{},
+			out: &v1alpha1.Issuer{},
+		},
+		"ClusterIssuer with deprecated http01 and dns01 config": {
+			in: &v1alpha1.ClusterIssuer{
+				TypeMeta:   typeMeta(v1alpha1.ClusterIssuerKind),
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       acmeIssuerSpec,
+			},
+			v2:  &v1alpha2.ClusterIssuer{},
+			out: &v1alpha1.ClusterIssuer{},
+		},
+		"Order with deprecated config": {
+			in: &v1alpha1.Order{
+				TypeMeta:   typeMeta(v1alpha1.OrderKind),
+				ObjectMeta: objectMeta,
+				Spec: v1alpha1.OrderSpec{
+					CSR:        []byte("csr"),
+					DNSNames:   []string{"example.com"},
+					IssuerRef:  v1alpha1.ObjectReference{Name: "test"},
+					CommonName: "example.com",
+					Config: []v1alpha1.DomainSolverConfig{
+						{Domains: []string{"example.com"}, SolverConfig: solverConfig},
+					},
+				},
+			},
+			v2:  &v1alpha2.Order{},
+			out: &v1alpha1.Order{},
+		},
+		"Challenge with deprecated config": {
+			in: &v1alpha1.Challenge{
+				TypeMeta:   typeMeta("Challenge"),
+				ObjectMeta: objectMeta,
+				Spec: v1alpha1.ChallengeSpec{
+					DNSName: "example.com",
+					Type:    "dns-01",
+					Config:  &solverConfig,
+				},
+			},
+			v2:  &v1alpha2.Challenge{},
+			out: &v1alpha1.Challenge{},
+		},
+		"ApprovalPolicy": {
+			in: &v1alpha1.ApprovalPolicy{
+				TypeMeta:   typeMeta(v1alpha1.ApprovalPolicyKind),
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1alpha1.ApprovalPolicySpec{
+					IssuerRef:  v1alpha1.ObjectReference{Name: "test", Kind: v1alpha1.ClusterIssuerKind},
+					Namespaces: []string{"default"},
+					Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"test": "label"}},
+				},
+			},
+			v2:  &v1alpha2.ApprovalPolicy{},
+			out: &v1alpha1.ApprovalPolicy{},
+		},
+		"CertificatePolicy": {
+			in: &v1alpha1.CertificatePolicy{
+				TypeMeta:   typeMeta(v1alpha1.CertificatePolicyKind),
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1alpha1.CertificatePolicySpec{
+					Selector: v1alpha1.CertificatePolicySelector{
+						IssuerRefs: []v1alpha1.ObjectReference{{Name: "test", Kind: v1alpha1.ClusterIssuerKind}},
+						Namespaces: []string{"default"},
+					},
+					AllowedDNSNames: []string{"*.example.com"},
+					AllowedIPRanges: []string{"10.0.0.0/8"},
+					AllowedPrivateKeys: []v1alpha1.CertificatePolicyPrivateKey{
+						{Algorithm: v1alpha1.RSAKeyAlgorithm, Sizes: []int{2048, 4096}},
+					},
+					MaxDuration: &metav1.Duration{Duration: time.Hour * 24 * 90},
+					AllowCA:     true,
+				},
+			},
+			v2:  &v1alpha2.CertificatePolicy{},
+			out: &v1alpha1.CertificatePolicy{},
+		},
+	}
+
+	h := NewHandler()
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			convert(t, h, test.in, v1alpha2.SchemeGroupVersion.String(), test.v2)
+			if gvk := test.v2.GetObjectKind().GroupVersionKind(); gvk.GroupVersion() != v1alpha2.SchemeGroupVersion {
+				t.Errorf("expected converted object to have group version %q, got %q", v1alpha2.SchemeGroupVersion, gvk.GroupVersion())
+			}
+
+			convert(t, h, test.v2, v1alpha1.SchemeGroupVersion.String(), test.out)
+			if !reflect.DeepEqual(test.in, test.out) {
+				t.Errorf("expected round-tripped object to equal %#v, got %#v", test.in, test.out)
+			}
+		})
+	}
+}
+
+func TestConvertFailure(t *testing.T) {
+	h := NewHandler()
+	crt := &v1alpha1.Certificate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.CertificateKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+	}
+
+	tests := map[string]struct {
+		desiredAPIVersion string
+		objects           []runtime.RawExtension
+	}{
+		"unsupported API group": {
+			desiredAPIVersion: "example.com/v1",
+			objects:           []runtime.RawExtension{mustMarshal(t, crt)},
+		},
+		"unknown API version": {
+			desiredAPIVersion: "certmanager.k8s.io/v1",
+			objects:           []runtime.RawExtension{mustMarshal(t, crt)},
+		},
+		"object that cannot be decoded": {
+			desiredAPIVersion: v1alpha2.SchemeGroupVersion.String(),
+			objects:           []runtime.RawExtension{{Raw: []byte(`{"apiVersion":"example.com/v1","kind":"Unknown"}`)}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := h.Convert(&apiext.ConversionRequest{
+				UID:               types.UID("test"),
+				DesiredAPIVersion: test.desiredAPIVersion,
+				Objects:           test.objects,
+			})
+			if resp.Result.Status != metav1.StatusFailure {
+				t.Errorf("expected conversion to fail")
+			}
+			if resp.Result.Message == "" {
+				t.Errorf("expected failure result to contain a message")
+			}
+			if len(resp.ConvertedObjects) != 0 {
+				t.Errorf("expected no converted objects, got %d", len(resp.ConvertedObjects))
+			}
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	crt := &v1alpha1.Certificate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.CertificateKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+	}
+	review := &apiext.ConversionReview{
+		Request: &apiext.ConversionRequest{
+			UID:               types.UID("test"),
+			DesiredAPIVersion: v1alpha2.SchemeGroupVersion.String(),
+			Objects:           []runtime.RawExtension{mustMarshal(t, crt)},
+		},
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	resp := &apiext.ConversionReview{}
+	if err := json.Unmarshal(rec.Body.Bytes(), resp); err != nil {
+		t.Fatalf("error decoding response: %v", err)
+	}
+	if resp.Request != nil {
+		t.Errorf("expected response to not contain the request")
+	}
+	if resp.Response == nil || resp.Response.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("expected a successful response, got %#v", resp.Response)
+	}
+
+	rec = httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader([]byte(`{}`))))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status code %d for a review without a request, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
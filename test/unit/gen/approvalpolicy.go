@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Jetstack cert-manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gen
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/leki75/cert-manager/pkg/apis/certmanager/v1alpha1"
+)
+
+type ApprovalPolicyModifier func(*v1alpha1.ApprovalPolicy)
+
+func ApprovalPolicy(name string, mods ...ApprovalPolicyModifier) *v1alpha1.ApprovalPolicy {
+	p := &v1alpha1.ApprovalPolicy{
+		ObjectMeta: ObjectMeta(name),
+	}
+	p.ObjectMeta.Namespace = ""
+	for _, mod := range mods {
+		mod(p)
+	}
+	return p
+}
+
+func SetApprovalPolicyIssuer(o v1alpha1.ObjectReference) ApprovalPolicyModifier {
+	return func(p *v1alpha1.ApprovalPolicy) {
+		p.Spec.IssuerRef = o
+	}
+}
+
+func SetApprovalPolicyNamespaces(namespaces ...string) ApprovalPolicyModifier {
+	return func(p *v1alpha1.ApprovalPolicy) {
+		p.Spec.Namespaces = namespaces
+	}
+}
+
+func SetApprovalPolicySelector(selector *metav1.LabelSelector) ApprovalPolicyModifier {
+	return func(p *v1alpha1.ApprovalPolicy) {
+		p.Spec.Selector = selector
+	}
+}
@@ -85,6 +85,51 @@ func SetCertificateKeyEncoding(keyEncoding v1alpha1.KeyEncoding) CertificateModi
 	}
 }
 
+func SetCertificateKeyUsages(usages ...v1alpha1.KeyUsage) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.Usages = usages
+	}
+}
+
+func SetCertificateSubject(subject v1alpha1.X509Subject) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.Subject = &subject
+	}
+}
+
+func SetCertificateKeyRotationPolicy(policy v1alpha1.KeyRotationPolicy) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.KeyRotationPolicy = policy
+	}
+}
+
+func SetCertificateKeystores(keystores v1alpha1.CertificateKeystores) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.Keystores = &keystores
+	}
+}
+
+func SetCertificateSecretTemplate(labels, annotations map[string]string) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.SecretTemplate = &v1alpha1.CertificateSecretTemplate{
+			Labels:      labels,
+			Annotations: annotations,
+		}
+	}
+}
+
+func SetCertificateRevisionHistoryLimit(limit int32) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.RevisionHistoryLimit = &limit
+	}
+}
+
+func SetCertificateRevocationPolicy(policy v1alpha1.RevocationPolicy) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Spec.RevocationPolicy = policy
+	}
+}
+
 func SetCertificateSecretName(secretName string) CertificateModifier {
 	return func(crt *v1alpha1.Certificate) {
 		crt.Spec.SecretName = secretName
@@ -113,12 +158,66 @@ func SetCertificateLastFailureTime(p metav1.Time) CertificateModifier {
 	}
 }
 
+func SetCertificateLastRenewalRequestTime(p metav1.Time) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.LastRenewalRequestTime = &p
+	}
+}
+
 func SetCertificateNotAfter(p metav1.Time) CertificateModifier {
 	return func(crt *v1alpha1.Certificate) {
 		crt.Status.NotAfter = &p
 	}
 }
 
+func SetCertificateNotBefore(p metav1.Time) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.NotBefore = &p
+	}
+}
+
+func SetCertificateRenewalTime(p metav1.Time) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.RenewalTime = &p
+	}
+}
+
+func SetCertificateRevision(revision int) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.Revision = &revision
+	}
+}
+
+func SetCertificateRevocation(r v1alpha1.CertificateRevocation) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.Revocation = &r
+	}
+}
+
+func SetCertificateGeneration(generation int64) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Generation = generation
+	}
+}
+
+func SetCertificateLastIssuanceFailureTime(p metav1.Time) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.LastIssuanceFailureTime = &p
+	}
+}
+
+func SetCertificateLastIssuanceFailureGeneration(generation int64) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.LastIssuanceFailureGeneration = &generation
+	}
+}
+
+func SetCertificateFailedIssuanceAttempts(attempts int) CertificateModifier {
+	return func(crt *v1alpha1.Certificate) {
+		crt.Status.FailedIssuanceAttempts = &attempts
+	}
+}
+
 func SetCertificateOrganization(orgs ...string) CertificateModifier {
 	return func(ch *v1alpha1.Certificate) {
 		ch.Spec.Organization = orgs
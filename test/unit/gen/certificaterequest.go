@@ -67,6 +67,12 @@ func SetCertificateRequestDuration(duration *metav1.Duration) CertificateRequest
 	}
 }
 
+func SetCertificateRequestKeyUsages(usages ...v1alpha1.KeyUsage) CertificateRequestModifier {
+	return func(cr *v1alpha1.CertificateRequest) {
+		cr.Spec.Usages = usages
+	}
+}
+
 func SetCertificateRequestCA(ca []byte) CertificateRequestModifier {
 	return func(cr *v1alpha1.CertificateRequest) {
 		cr.Status.CA = ca
@@ -100,3 +106,21 @@ func SetCertificateRequestNamespace(namespace string) CertificateRequestModifier
 		cr.ObjectMeta.Namespace = namespace
 	}
 }
+
+func SetCertificateRequestAnnotations(annotations map[string]string) CertificateRequestModifier {
+	return func(cr *v1alpha1.CertificateRequest) {
+		cr.ObjectMeta.Annotations = annotations
+	}
+}
+
+func SetCertificateRequestLabels(labels map[string]string) CertificateRequestModifier {
+	return func(cr *v1alpha1.CertificateRequest) {
+		cr.ObjectMeta.Labels = labels
+	}
+}
+
+func SetCertificateRequestOwnerReference(ref metav1.OwnerReference) CertificateRequestModifier {
+	return func(cr *v1alpha1.CertificateRequest) {
+		cr.ObjectMeta.OwnerReferences = []metav1.OwnerReference{ref}
+	}
+}
@@ -69,12 +69,30 @@ func SetIssuerCA(a v1alpha1.CAIssuer) IssuerModifier {
 	}
 }
 
+func SetIssuerVault(v v1alpha1.VaultIssuer) IssuerModifier {
+	return func(iss v1alpha1.GenericIssuer) {
+		iss.GetSpec().Vault = &v
+	}
+}
+
+func SetIssuerVenafi(v v1alpha1.VenafiIssuer) IssuerModifier {
+	return func(iss v1alpha1.GenericIssuer) {
+		iss.GetSpec().Venafi = &v
+	}
+}
+
 func SetIssuerSelfSigned(a v1alpha1.SelfSignedIssuer) IssuerModifier {
 	return func(iss v1alpha1.GenericIssuer) {
 		iss.GetSpec().SelfSigned = &a
 	}
 }
 
+func SetIssuerNamespaceSelector(sel v1alpha1.IssuerNamespaceSelector) IssuerModifier {
+	return func(iss v1alpha1.GenericIssuer) {
+		iss.GetSpec().NamespaceSelector = &sel
+	}
+}
+
 func AddIssuerCondition(c v1alpha1.IssuerCondition) IssuerModifier {
 	return func(iss v1alpha1.GenericIssuer) {
 		iss.GetStatus().Conditions = append(iss.GetStatus().Conditions, c)
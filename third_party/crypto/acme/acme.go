@@ -19,6 +19,7 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
@@ -30,6 +31,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -82,6 +84,12 @@ type Client struct {
 	// "myclient/1.2.3".
 	UserAgent string
 
+	// PreferredChain optionally names the common name of a root or
+	// intermediate certificate. If the default certificate chain returned by
+	// the CA does not contain a matching certificate, the alternate chains
+	// advertised by the CA are searched for one that does.
+	PreferredChain string
+
 	noncesMu sync.Mutex
 	nonces   map[string]struct{} // nonces collected from previous responses
 
@@ -121,9 +129,10 @@ func (c *Client) Discover(ctx context.Context) (Directory, error) {
 		NewAccount string
 		NewOrder   string
 		NewAuthz   string
-		RevokeCert string
-		KeyChange  string
-		Meta       struct {
+		RevokeCert  string
+		KeyChange   string
+		RenewalInfo string
+		Meta        struct {
 			TermsOfService          string
 			Website                 string
 			CAAIdentities           []string
@@ -144,10 +153,77 @@ func (c *Client) Discover(ctx context.Context) (Directory, error) {
 		Website:                 v.Meta.Website,
 		CAA:                     v.Meta.CAAIdentities,
 		ExternalAccountRequired: v.Meta.ExternalAccountRequired,
+		RenewalInfoURL:          v.RenewalInfo,
 	}
 	return *c.dir, nil
 }
 
+// GetRenewalInfo retrieves the renewal information suggested by the ACME
+// server for the given certificate, using the ACME Renewal Information (ARI)
+// extension. It returns ErrRenewalInfoNotSupported if the server does not
+// advertise a renewalInfo endpoint.
+func (c *Client) GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (*RenewalInfo, error) {
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dir.RenewalInfoURL == "" {
+		return nil, ErrRenewalInfoNotSupported
+	}
+
+	id, err := renewalInfoCertID(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.get(ctx, strings.TrimSuffix(dir.RenewalInfoURL, "/")+"/"+id)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, responseError(res)
+	}
+
+	var v struct {
+		SuggestedWindow RenewalWindow `json:"suggestedWindow"`
+		ExplanationURL  string        `json:"explanationURL"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+		return nil, fmt.Errorf("acme: invalid response: %v", err)
+	}
+	if v.SuggestedWindow.End.Before(v.SuggestedWindow.Start) {
+		return nil, fmt.Errorf("acme: invalid suggested window: end %s is before start %s", v.SuggestedWindow.End, v.SuggestedWindow.Start)
+	}
+
+	return &RenewalInfo{
+		SuggestedWindow: v.SuggestedWindow,
+		ExplanationURL:  v.ExplanationURL,
+		RetryAfter:      retryAfter(res.Header.Get("Retry-After")),
+	}, nil
+}
+
+// renewalInfoCertID returns the unique identifier of a certificate used by the
+// renewalInfo endpoint. It is made of the base64url encoded key identifier of
+// the certificate's authority key identifier extension and the base64url
+// encoded DER bytes of its serial number, separated by a period.
+func renewalInfoCertID(cert *x509.Certificate) (string, error) {
+	if len(cert.AuthorityKeyId) == 0 {
+		return "", errors.New("acme: certificate has no authority key identifier")
+	}
+	if cert.SerialNumber == nil {
+		return "", errors.New("acme: certificate has no serial number")
+	}
+	// the serial number is encoded as the value of a DER INTEGER, which
+	// requires a leading zero byte if its most significant bit is set.
+	serial := cert.SerialNumber.Bytes()
+	if len(serial) == 0 || serial[0]&0x80 != 0 {
+		serial = append([]byte{0}, serial...)
+	}
+	return base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId) + "." +
+		base64.RawURLEncoding.EncodeToString(serial), nil
+}
+
 // CreateOrder creates a new certificate order. The input order argument is not
 // modified and can be built using NewOrder.
 func (c *Client) CreateOrder(ctx context.Context, order *Order) (*Order, error) {
@@ -563,9 +639,10 @@ func (c *Client) HTTP01ChallengePath(token string) string {
 // the Account. Only the Contact field can be updated.
 func (c *Client) doAccount(ctx context.Context, url string, getExistingWithKey bool, acct *Account) (*Account, error) {
 	req := struct {
-		Contact     []string `json:"contact,omitempty"`
-		TermsAgreed bool     `json:"termsOfServiceAgreed,omitempty"`
-		GetExisting bool     `json:"onlyReturnExisting,omitempty"`
+		Contact     []string        `json:"contact,omitempty"`
+		TermsAgreed bool            `json:"termsOfServiceAgreed,omitempty"`
+		GetExisting bool            `json:"onlyReturnExisting,omitempty"`
+		EAB         json.RawMessage `json:"externalAccountBinding,omitempty"`
 	}{
 		GetExisting: getExistingWithKey,
 	}
@@ -577,6 +654,13 @@ func (c *Client) doAccount(ctx context.Context, url string, getExistingWithKey b
 		req.Contact = acct.Contact
 		req.TermsAgreed = acct.TermsAgreed
 	}
+	if acct != nil && acct.ExternalAccountBinding != nil && accountURL == "" {
+		eab, err := c.encodeExternalAccountBinding(url, acct.ExternalAccountBinding)
+		if err != nil {
+			return nil, err
+		}
+		req.EAB = eab
+	}
 	res, err := c.retryPostJWS(ctx, c.Key, accountURL, url, req)
 	if err != nil {
 		return nil, err
@@ -621,6 +705,20 @@ func (c *Client) doAccount(ctx context.Context, url string, getExistingWithKey b
 	return a, nil
 }
 
+// encodeExternalAccountBinding returns the externalAccountBinding JWS for
+// a newAccount request to url, binding the client's account key to eab.
+func (c *Client) encodeExternalAccountBinding(url string, eab *ExternalAccountBinding) (json.RawMessage, error) {
+	jwk, err := jwkEncode(c.Key.Public())
+	if err != nil {
+		return nil, err
+	}
+	b, err := jwsWithMAC(eab.Key, eab.Algorithm, eab.KID, url, []byte(jwk))
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(b), nil
+}
+
 // cacheAccount ensures that the account URL is cached and returns it.
 func (c *Client) cacheAccountURL(ctx context.Context) (string, error) {
 	c.urlMu.Lock()
@@ -830,22 +928,51 @@ func nonceFromHeader(h http.Header) string {
 	return h.Get("Replay-Nonce")
 }
 
+// GetCertificate retrieves the certificate chain at url.
+// If c.PreferredChain is set and the default chain does not match it, the
+// alternate chains linked from the response are retrieved, and the first
+// matching chain is returned instead. If no chain matches, the default chain
+// is returned. An error is returned if an alternate chain cannot be retrieved.
 func (c *Client) GetCertificate(ctx context.Context, url string) ([][]byte, error) {
 	if _, err := c.Discover(ctx); err != nil {
 		return nil, err
 	}
 
-	res, err := c.postWithJWSAccount(ctx, url, nil)
+	chain, alternates, err := c.fetchCertificate(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	if c.PreferredChain == "" || chainMatches(chain, c.PreferredChain) {
+		return chain, nil
+	}
+
+	for _, alt := range alternates {
+		altChain, _, err := c.fetchCertificate(ctx, alt)
+		if err != nil {
+			return nil, err
+		}
+		if chainMatches(altChain, c.PreferredChain) {
+			return altChain, nil
+		}
+	}
+
+	return chain, nil
+}
+
+// fetchCertificate retrieves the certificate chain at url, along with the
+// URLs of any alternate chains advertised in the response.
+func (c *Client) fetchCertificate(ctx context.Context, url string) ([][]byte, []string, error) {
+	res, err := c.postWithJWSAccount(ctx, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 	defer res.Body.Close()
 	data, err := ioutil.ReadAll(io.LimitReader(res.Body, maxChainSize+1))
 	if err != nil {
-		return nil, fmt.Errorf("acme: error getting certificate: %v", err)
+		return nil, nil, fmt.Errorf("acme: error getting certificate: %v", err)
 	}
 	if len(data) > maxChainSize {
-		return nil, errors.New("acme: certificate chain is too big")
+		return nil, nil, errors.New("acme: certificate chain is too big")
 	}
 	var chain [][]byte
 	for {
@@ -853,19 +980,41 @@ func (c *Client) GetCertificate(ctx context.Context, url string) ([][]byte, erro
 		p, data = pem.Decode(data)
 		if p == nil {
 			if len(chain) == 0 {
-				return nil, errors.New("acme: invalid PEM certificate chain")
+				return nil, nil, errors.New("acme: invalid PEM certificate chain")
 			}
 			break
 		}
 		if len(chain) == maxChainLen {
-			return nil, errors.New("acme: certificate chain is too long")
+			return nil, nil, errors.New("acme: certificate chain is too long")
 		}
 		if p.Type != "CERTIFICATE" {
-			return nil, fmt.Errorf("acme: invalid PEM block type %q", p.Type)
+			return nil, nil, fmt.Errorf("acme: invalid PEM block type %q", p.Type)
 		}
 		chain = append(chain, p.Bytes)
 	}
-	return chain, nil
+	alternates, err := resolveLinks(url, linkHeader(res.Header, "alternate"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return chain, alternates, nil
+}
+
+// chainMatches returns true if any certificate in chain was issued by, or
+// (excluding the leaf) has the subject common name name.
+func chainMatches(chain [][]byte, name string) bool {
+	for i, der := range chain {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return false
+		}
+		if cert.Issuer.CommonName == name {
+			return true
+		}
+		if i > 0 && cert.Subject.CommonName == name {
+			return true
+		}
+	}
+	return false
 }
 
 // responseError creates an error of Error type from resp.
@@ -974,5 +1123,44 @@ func resolveLocation(base string, h http.Header) (string, error) {
 	return u.String(), nil
 }
 
+// linkHeader returns the URI-Reference values of all Link headers with
+// relation-type rel.
+// See https://tools.ietf.org/html/rfc8288#section-3 for details.
+func linkHeader(h http.Header, rel string) []string {
+	var links []string
+	for _, v := range h["Link"] {
+		for _, link := range strings.Split(v, ",") {
+			parts := strings.Split(link, ";")
+			for _, p := range parts[1:] {
+				p = strings.TrimSpace(p)
+				if !strings.HasPrefix(p, "rel=") {
+					continue
+				}
+				if strings.Trim(p[len("rel="):], `"`) == rel {
+					links = append(links, strings.Trim(strings.TrimSpace(parts[0]), "<>"))
+				}
+			}
+		}
+	}
+	return links
+}
+
+// resolveLinks resolves each of links relative to base.
+func resolveLinks(base string, links []string) ([]string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make([]string, len(links))
+	for i, l := range links {
+		lu, err := u.Parse(l)
+		if err != nil {
+			return nil, fmt.Errorf("acme: error parsing Link: %s", err)
+		}
+		resolved[i] = lu.String()
+	}
+	return resolved, nil
+}
+
 // timeNow is useful for testing for fixed current time.
 var timeNow = time.Now
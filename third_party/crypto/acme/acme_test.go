@@ -5,8 +5,11 @@
 package acme
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/base64"
@@ -65,12 +68,13 @@ func decodeJWSHead(r *http.Request) (*jwsHead, error) {
 
 func TestDiscover(t *testing.T) {
 	const (
-		keyChange  = "https://example.com/acme/key-change"
-		newAccount = "https://example.com/acme/new-account"
-		newNonce   = "https://example.com/acme/new-nonce"
-		newOrder   = "https://example.com/acme/new-order"
-		revokeCert = "https://example.com/acme/revoke-cert"
-		terms      = "https://example.com/acme/terms"
+		keyChange   = "https://example.com/acme/key-change"
+		newAccount  = "https://example.com/acme/new-account"
+		newNonce    = "https://example.com/acme/new-nonce"
+		newOrder    = "https://example.com/acme/new-order"
+		revokeCert  = "https://example.com/acme/revoke-cert"
+		renewalInfo = "https://example.com/acme/renewal-info"
+		terms       = "https://example.com/acme/terms"
 	)
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -80,10 +84,11 @@ func TestDiscover(t *testing.T) {
 			"newNonce": %q,
 			"newOrder": %q,
 			"revokeCert": %q,
+			"renewalInfo": %q,
 			"meta": {
 				"termsOfService": %q
 			}
-		}`, keyChange, newAccount, newNonce, newOrder, revokeCert, terms)
+		}`, keyChange, newAccount, newNonce, newOrder, revokeCert, renewalInfo, terms)
 	}))
 	defer ts.Close()
 	c := Client{DirectoryURL: ts.URL}
@@ -103,6 +108,9 @@ func TestDiscover(t *testing.T) {
 	if dir.RevokeCertURL != revokeCert {
 		t.Errorf("dir.RevokeCertURL = %q; want %q", dir.RevokeCertURL, revokeCert)
 	}
+	if dir.RenewalInfoURL != renewalInfo {
+		t.Errorf("dir.RenewalInfoURL = %q; want %q", dir.RenewalInfoURL, renewalInfo)
+	}
 	if dir.Terms != terms {
 		t.Errorf("dir.Terms = %q; want %q", dir.Terms, terms)
 	}
@@ -160,6 +168,100 @@ func TestCreateAccount(t *testing.T) {
 	}
 }
 
+func TestCreateAccountExternalAccountBinding(t *testing.T) {
+	hmacKey := []byte("super-secret-hmac-key")
+	var accountURL string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" {
+			w.Header().Set("Replay-Nonce", "test-nonce")
+			return
+		}
+
+		var j struct {
+			ExternalAccountBinding struct {
+				Protected string
+				Payload   string
+				Signature string
+			}
+		}
+		decodeJWSRequest(t, &j, r)
+		eab := j.ExternalAccountBinding
+
+		head, err := base64.RawURLEncoding.DecodeString(eab.Protected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var h struct {
+			Alg string
+			Kid string
+			URL string
+		}
+		if err := json.Unmarshal(head, &h); err != nil {
+			t.Fatal(err)
+		}
+		if h.Alg != "HS256" {
+			t.Errorf("h.Alg = %q; want HS256", h.Alg)
+		}
+		if h.Kid != "kid-1" {
+			t.Errorf("h.Kid = %q; want kid-1", h.Kid)
+		}
+		if h.URL != accountURL {
+			t.Errorf("h.URL = %q; want %q", h.URL, accountURL)
+		}
+
+		payload, err := base64.RawURLEncoding.DecodeString(eab.Payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		jwk, _ := jwkEncode(testKeyEC.Public())
+		if string(payload) != jwk {
+			t.Errorf("payload = %s; want %s", payload, jwk)
+		}
+
+		mac := hmac.New(sha256.New, hmacKey)
+		mac.Write([]byte(eab.Protected + "." + eab.Payload))
+		if sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)); sig != eab.Signature {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"type":"urn:ietf:params:acme:error:unauthorized","detail":"invalid external account binding"}`)
+			return
+		}
+
+		w.Header().Set("Location", "https://example.com/acme/account/1")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"valid"}`)
+	}))
+	defer ts.Close()
+	accountURL = ts.URL
+
+	c := Client{Key: testKeyEC, dir: &Directory{NewAccountURL: ts.URL, NewNonceURL: ts.URL}}
+	a := &Account{
+		TermsAgreed:            true,
+		ExternalAccountBinding: &ExternalAccountBinding{KID: "kid-1", Key: hmacKey},
+	}
+	a, err := c.CreateAccount(context.Background(), a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.URL != "https://example.com/acme/account/1" {
+		t.Errorf("a.URL = %q; want https://example.com/acme/account/1", a.URL)
+	}
+
+	c = Client{Key: testKeyEC, dir: &Directory{NewAccountURL: ts.URL, NewNonceURL: ts.URL}}
+	a = &Account{
+		TermsAgreed:            true,
+		ExternalAccountBinding: &ExternalAccountBinding{KID: "kid-1", Key: []byte("wrong-key")},
+	}
+	_, err = c.CreateAccount(context.Background(), a)
+	acmeErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("err = %v (%T); want *Error", err, err)
+	}
+	if acmeErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("acmeErr.StatusCode = %d; want %d", acmeErr.StatusCode, http.StatusBadRequest)
+	}
+}
+
 func TestUpdateAccount(t *testing.T) {
 	contacts := []string{"mailto:admin@example.com"}
 
@@ -777,6 +879,160 @@ func TestFinalizeOrder(t *testing.T) {
 	}
 }
 
+func TestGetCertificatePreferredChain(t *testing.T) {
+	newCert := func(subject, issuer string) []byte {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: subject},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		parent := &x509.Certificate{Subject: pkix.Name{CommonName: issuer}}
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &testKeyEC.PublicKey, testKeyEC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der
+	}
+	leafA := newCert("example.com", "Intermediate A")
+	intA := newCert("Intermediate A", "Root A")
+	leafB := newCert("example.com", "Intermediate B")
+	intB := newCert("Intermediate B", "Root B")
+	leafC := newCert("example.com", "Intermediate C")
+	intC := newCert("Intermediate C", "Root C")
+
+	altGets := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" {
+			w.Header().Set("Replay-Nonce", "test-nonce")
+			return
+		}
+		switch r.URL.Path {
+		case "/cert":
+			w.Header().Add("Link", `<https://example.com/acme/directory>;rel="index"`)
+			w.Header().Add("Link", `</cert/alt/1>;rel="alternate", </cert/alt/2>;rel="alternate"`)
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: leafA})
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: intA})
+		case "/cert/broken":
+			w.Header().Add("Link", `</cert/alt/missing>;rel="alternate", </cert/alt/1>;rel="alternate"`)
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: leafA})
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: intA})
+		case "/cert/alt/1":
+			altGets[r.URL.Path]++
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: leafB})
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: intB})
+		case "/cert/alt/2":
+			altGets[r.URL.Path]++
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: leafC})
+			pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: intC})
+		case "/cert/alt/missing":
+			altGets[r.URL.Path]++
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request to %q", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	tests := map[string]struct {
+		url            string
+		preferredChain string
+		expected       []byte
+		expectErr      bool
+		expectedGets   map[string]int
+	}{
+		"default chain without a preferred chain": {
+			url:          "/cert",
+			expected:     intA,
+			expectedGets: map[string]int{},
+		},
+		"default chain matching the preferred root": {
+			url:            "/cert",
+			preferredChain: "Root A",
+			expected:       intA,
+			expectedGets:   map[string]int{},
+		},
+		"alternate chain matching the preferred root": {
+			url:            "/cert",
+			preferredChain: "Root B",
+			expected:       intB,
+			expectedGets:   map[string]int{"/cert/alt/1": 1},
+		},
+		"second alternate chain matching the preferred root": {
+			url:            "/cert",
+			preferredChain: "Root C",
+			expected:       intC,
+			expectedGets:   map[string]int{"/cert/alt/1": 1, "/cert/alt/2": 1},
+		},
+		"alternate chain matching the preferred intermediate": {
+			url:            "/cert",
+			preferredChain: "Intermediate B",
+			expected:       intB,
+			expectedGets:   map[string]int{"/cert/alt/1": 1},
+		},
+		"second alternate chain matching the preferred intermediate": {
+			url:            "/cert",
+			preferredChain: "Intermediate C",
+			expected:       intC,
+			expectedGets:   map[string]int{"/cert/alt/1": 1, "/cert/alt/2": 1},
+		},
+		"leaf common names are not matched": {
+			url:            "/cert",
+			preferredChain: "example.com",
+			expected:       intA,
+			expectedGets:   map[string]int{"/cert/alt/1": 1, "/cert/alt/2": 1},
+		},
+		"default chain if no chain matches": {
+			url:            "/cert",
+			preferredChain: "Root D",
+			expected:       intA,
+			expectedGets:   map[string]int{"/cert/alt/1": 1, "/cert/alt/2": 1},
+		},
+		"error if an alternate chain cannot be retrieved": {
+			url:            "/cert/broken",
+			preferredChain: "Root B",
+			expectErr:      true,
+			expectedGets:   map[string]int{"/cert/alt/missing": 1},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k := range altGets {
+				delete(altGets, k)
+			}
+			c := Client{
+				Key:            testKeyEC,
+				PreferredChain: test.preferredChain,
+				accountURL:     "https://example.com/acme/account",
+				dir:            &Directory{NewNonceURL: ts.URL},
+			}
+			chain, err := c.GetCertificate(context.Background(), ts.URL+test.url)
+			switch {
+			case test.expectErr && err == nil:
+				t.Errorf("expected an error but got none")
+			case !test.expectErr && err != nil:
+				t.Fatal(err)
+			case !test.expectErr && (len(chain) != 2 || !bytes.Equal(chain[1], test.expected)):
+				t.Errorf("unexpected chain returned")
+			}
+			if !reflect.DeepEqual(altGets, test.expectedGets) {
+				t.Errorf("altGets = %v; want %v", altGets, test.expectedGets)
+			}
+		})
+	}
+}
+
+func TestLinkHeader(t *testing.T) {
+	h := http.Header{}
+	h.Add("Link", `<https://example.com/acme/directory>;rel="index"`)
+	h.Add("Link", `<https://example.com/cert/1>; rel="alternate", <https://example.com/cert/2>;rel=alternate`)
+	links := linkHeader(h, "alternate")
+	want := []string{"https://example.com/cert/1", "https://example.com/cert/2"}
+	if !reflect.DeepEqual(links, want) {
+		t.Errorf("links = %v; want %v", links, want)
+	}
+}
+
 func TestWaitOrderInvalid(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "HEAD" {
@@ -1157,3 +1413,88 @@ func TestBackoff(t *testing.T) {
 		t.Errorf("d = %v; want %v", d, bound)
 	}
 }
+
+func TestRenewalInfoCertID(t *testing.T) {
+	// example taken from the ACME Renewal Information specification
+	cert := &x509.Certificate{
+		AuthorityKeyId: []byte{0x69, 0x88, 0x5b, 0x6b, 0x87, 0x46, 0x40, 0x41, 0xe1, 0xb3,
+			0x7b, 0x84, 0x7b, 0xa0, 0xae, 0x2c, 0xde, 0x01, 0xc8, 0xd4},
+		SerialNumber: big.NewInt(0x87654321),
+	}
+	id, err := renewalInfoCertID(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"; id != want {
+		t.Errorf("renewalInfoCertID = %q; want %q", id, want)
+	}
+
+	if _, err := renewalInfoCertID(&x509.Certificate{SerialNumber: big.NewInt(1)}); err == nil {
+		t.Errorf("expected an error for a certificate without an authority key identifier")
+	}
+}
+
+func TestGetRenewalInfo(t *testing.T) {
+	cert := &x509.Certificate{
+		AuthorityKeyId: []byte{0x01, 0x02, 0x03},
+		SerialNumber:   big.NewInt(0x1234),
+	}
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"renewalInfo": %q}`, ts.URL+"/renewal-info")
+		case "/renewal-info/AQID.EjQ":
+			if r.Method != "GET" {
+				t.Errorf("r.Method = %q; want GET", r.Method)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Retry-After", "21600")
+			fmt.Fprintf(w, `{
+				"suggestedWindow": {"start": %q, "end": %q},
+				"explanationURL": "https://example.com/incident"
+			}`, start.Format(time.RFC3339), end.Format(time.RFC3339))
+		default:
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	now := time.Now()
+	defer func(old func() time.Time) { timeNow = old }(timeNow)
+	timeNow = func() time.Time { return now }
+
+	c := Client{DirectoryURL: ts.URL}
+	info, err := c.GetRenewalInfo(context.Background(), cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.SuggestedWindow.Start.Equal(start) || !info.SuggestedWindow.End.Equal(end) {
+		t.Errorf("info.SuggestedWindow = %+v; want %s to %s", info.SuggestedWindow, start, end)
+	}
+	if info.ExplanationURL != "https://example.com/incident" {
+		t.Errorf("info.ExplanationURL = %q", info.ExplanationURL)
+	}
+	if want := now.Add(6 * time.Hour); !info.RetryAfter.Equal(want) {
+		t.Errorf("info.RetryAfter = %s; want %s", info.RetryAfter, want)
+	}
+}
+
+func TestGetRenewalInfoNotSupported(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"newOrder": "https://example.com/acme/new-order"}`)
+	}))
+	defer ts.Close()
+
+	c := Client{DirectoryURL: ts.URL}
+	_, err := c.GetRenewalInfo(context.Background(), &x509.Certificate{})
+	if err != ErrRenewalInfoNotSupported {
+		t.Errorf("err = %v; want %v", err, ErrRenewalInfoNotSupported)
+	}
+}
@@ -7,12 +7,14 @@ package acme
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
 	_ "crypto/sha512" // need for EC keys
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 )
@@ -63,6 +65,55 @@ func jwsEncodeJSON(claimset interface{}, key crypto.Signer, accountURL, url, non
 	return json.Marshal(&enc)
 }
 
+// jwsWithMAC creates and signs a JWS using the given key and algorithm.
+// It is used to construct the externalAccountBinding field of a newAccount
+// request, in which case payload is the JWK of the account key and url is
+// the newAccount URL.
+// See https://tools.ietf.org/html/rfc8555#section-7.3.4.
+func jwsWithMAC(key []byte, alg, kid, url string, payload []byte) ([]byte, error) {
+	if alg == "" {
+		alg = "HS256"
+	}
+	sha := macHasher(alg)
+	if sha == 0 {
+		return nil, fmt.Errorf("acme: unsupported external account binding algorithm %q", alg)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("acme: external account binding key is empty")
+	}
+	phead := fmt.Sprintf(`{"alg":%q,"kid":%q,"url":%q}`, alg, kid, url)
+	phead = base64.RawURLEncoding.EncodeToString([]byte(phead))
+	pl := base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha.New, key)
+	mac.Write([]byte(phead + "." + pl))
+
+	enc := struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Sig       string `json:"signature"`
+	}{
+		Protected: phead,
+		Payload:   pl,
+		Sig:       base64.RawURLEncoding.EncodeToString(mac.Sum(nil)),
+	}
+	return json.Marshal(&enc)
+}
+
+// macHasher returns the hash function used by the given JWS HMAC algorithm.
+// It returns 0 if the algorithm is not supported.
+func macHasher(alg string) crypto.Hash {
+	switch alg {
+	case "HS256":
+		return crypto.SHA256
+	case "HS384":
+		return crypto.SHA384
+	case "HS512":
+		return crypto.SHA512
+	}
+	return 0
+}
+
 // jwkEncode encodes public part of an RSA or ECDSA key into a JWK.
 // The result is also suitable for creating a JWK thumbprint.
 // https://tools.ietf.org/html/rfc7517
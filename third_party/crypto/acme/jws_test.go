@@ -7,7 +7,9 @@ package acme
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"crypto/hmac"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
@@ -239,6 +241,72 @@ func TestJWSEncodeJSONEC(t *testing.T) {
 		if head.JWK.Y != test.y {
 			t.Errorf("%d: head.JWK.Y = %q; want %q", i, head.JWK.Y, test.y)
 		}
+
+		sig, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+		if err != nil {
+			t.Errorf("%d: jws.Signature: %v", i, err)
+			continue
+		}
+		_, hash := jwsHasher(test.key)
+		h := hash.New()
+		h.Write([]byte(jws.Protected + "." + jws.Payload))
+		r := new(big.Int).SetBytes(sig[:len(sig)/2])
+		s := new(big.Int).SetBytes(sig[len(sig)/2:])
+		if !ecdsa.Verify(&test.key.PublicKey, h.Sum(nil), r, s) {
+			t.Errorf("%d: invalid %s signature", i, test.alg)
+		}
+	}
+}
+
+func TestJWSWithMAC(t *testing.T) {
+	// Symmetric key from RFC 7520 Section 3.5.
+	key, err := base64.RawURLEncoding.DecodeString("hJtXIZ2uSN5kbQfbtTNWbpdmhkV8FJG-Onbc6mxCcYg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := jwsWithMAC(key, "", "kid-1", "https://example.com/acme/new-account", []byte(`{"kty":"EC"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jws struct {
+		Protected string
+		Payload   string
+		Signature string
+	}
+	if err := json.Unmarshal(b, &jws); err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHead := `{"alg":"HS256","kid":"kid-1","url":"https://example.com/acme/new-account"}`
+	if string(head) != wantHead {
+		t.Errorf("protected = %s; want %s", head, wantHead)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(payload) != `{"kty":"EC"}` {
+		t.Errorf("payload = %s; want {\"kty\":\"EC\"}", payload)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(jws.Protected + "." + jws.Payload))
+	if sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)); sig != jws.Signature {
+		t.Errorf("signature = %q; want %q", jws.Signature, sig)
+	}
+}
+
+func TestJWSWithMACErrors(t *testing.T) {
+	if _, err := jwsWithMAC([]byte("key"), "RS256", "kid", "url", nil); err == nil {
+		t.Error("expected an error for an unsupported algorithm")
+	}
+	if _, err := jwsWithMAC(nil, "HS512", "kid", "url", nil); err == nil {
+		t.Error("expected an error for an empty key")
 	}
 }
 
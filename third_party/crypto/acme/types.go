@@ -43,6 +43,10 @@ const (
 // ErrUnsupportedKey is returned when an unsupported key type is encountered.
 var ErrUnsupportedKey = errors.New("acme: unknown key type; only RSA and ECDSA are supported")
 
+// ErrRenewalInfoNotSupported is returned by GetRenewalInfo when the ACME
+// server does not advertise a renewalInfo endpoint in its directory.
+var ErrRenewalInfoNotSupported = errors.New("acme: server does not support renewal information")
+
 // Error is an ACME error as defined in RFC 7807, Problem Details for HTTP APIs.
 type Error struct {
 	// StatusCode is The HTTP status code generated by the origin server.
@@ -156,6 +160,25 @@ type Account struct {
 	// OrdersURL is the URL used to fetch a list of orders submitted by this
 	// account.
 	OrdersURL string
+
+	// ExternalAccountBinding, if set, binds the new account to an account
+	// held with a non-ACME system. It is only used when creating an account.
+	ExternalAccountBinding *ExternalAccountBinding
+}
+
+// ExternalAccountBinding contains the credentials used to bind a new ACME
+// account to an existing account with the CA.
+// See https://tools.ietf.org/html/rfc8555#section-7.3.4.
+type ExternalAccountBinding struct {
+	// KID is the key identifier provided by the CA.
+	KID string
+
+	// Key is the symmetric MAC key provided by the CA.
+	Key []byte
+
+	// Algorithm is the MAC algorithm used to sign the binding. One of
+	// HS256, HS384 or HS512. If empty, HS256 is used.
+	Algorithm string
 }
 
 // Directory is ACME server discovery data.
@@ -194,6 +217,34 @@ type Directory struct {
 	// new account requests include an ExternalAccountBinding field associating
 	// the new account with an external account.
 	ExternalAccountRequired bool
+
+	// RenewalInfoURL is used to retrieve renewal information for certificates,
+	// as defined by the ACME Renewal Information (ARI) extension.
+	// It is empty if the server does not support ARI.
+	RenewalInfoURL string
+}
+
+// RenewalInfo is the renewal information suggested by the ACME server for
+// a certificate, as defined by the ACME Renewal Information (ARI) extension.
+type RenewalInfo struct {
+	// SuggestedWindow is the window of time in which the server suggests the
+	// certificate should be renewed.
+	SuggestedWindow RenewalWindow
+
+	// ExplanationURL optionally locates a page explaining why the suggested
+	// window has been chosen, for example following a mass revocation event.
+	ExplanationURL string
+
+	// RetryAfter is the time after which the renewal information should be
+	// requested again, as indicated by the server's Retry-After header.
+	// It is zero if the server did not provide one.
+	RetryAfter time.Time
+}
+
+// RenewalWindow is a window of time in which a certificate should be renewed.
+type RenewalWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
 }
 
 // NewOrder creates a new order with the domains provided, suitable for creating